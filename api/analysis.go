@@ -0,0 +1,276 @@
+package api
+
+import (
+	"duel-masters/game/cnd"
+	"duel-masters/game/match"
+	"sort"
+
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// Turns for which the chance of having a castable play is calculated
+var analysisTurns = []int{2, 3, 4}
+
+// analysisCard is the part of a card that is relevant for the deck analysis
+type analysisCard struct {
+	civ       string
+	cost      int
+	creature  bool
+	spell     bool
+	evolution bool
+	trigger   bool
+	family    string
+}
+
+// countEntry is the number of cards in a deck sharing a property
+type countEntry struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+// castableChance is the chance to have a castable play on a given turn, going first or second
+type castableChance struct {
+	Turn   int     `json:"turn"`
+	First  float64 `json:"first"`
+	Second float64 `json:"second"`
+}
+
+// deckAnalysis holds the numbers of a deck
+type deckAnalysis struct {
+	Rules           string           `json:"rules"`
+	Cards           int              `json:"cards"`
+	ManaCurve       []int            `json:"manaCurve"`
+	Civilizations   []countEntry     `json:"civilizations"`
+	Creatures       int              `json:"creatures"`
+	Evolutions      int              `json:"evolutions"`
+	Spells          int              `json:"spells"`
+	ShieldTriggers  int              `json:"shieldTriggers"`
+	Families        []countEntry     `json:"families"`
+	TriggerInShield float64          `json:"triggerInShields"`
+	Castable        []castableChance `json:"castable"`
+}
+
+// AnalyzeDeck returns the mana curve, the distribution of civilizations, card types and families
+// of the deck, and the chances to have a shield trigger in the shields and a castable play early on
+// in a match that is played with the given rules
+func AnalyzeDeck(deck []string, rules match.Rules) deckAnalysis {
+
+	cards := make([]analysisCard, 0)
+
+	for _, uid := range deck {
+
+		card, err := match.Inspect(uid)
+
+		if err != nil {
+			continue
+		}
+
+		cards = append(cards, analysisCard{
+			civ:       card.Civ,
+			cost:      card.ManaCost,
+			creature:  card.HasMarker(cnd.Creature),
+			spell:     card.HasMarker(cnd.Spell),
+			evolution: card.HasMarker(cnd.Evolution),
+			trigger:   card.HasMarker(cnd.ShieldTrigger),
+			family:    card.Family,
+		})
+
+	}
+
+	result := deckAnalysis{
+		Rules:     rules.Name,
+		Cards:     len(cards),
+		ManaCurve: make([]int, 0),
+		Castable:  make([]castableChance, 0),
+	}
+
+	civs := make(map[string]int)
+	families := make(map[string]int)
+
+	for _, c := range cards {
+
+		for len(result.ManaCurve) <= c.cost {
+			result.ManaCurve = append(result.ManaCurve, 0)
+		}
+
+		result.ManaCurve[c.cost]++
+		civs[c.civ]++
+
+		if c.spell {
+			result.Spells++
+		}
+
+		if c.creature {
+			result.Creatures++
+			families[c.family]++
+		}
+
+		if c.evolution {
+			result.Evolutions++
+		}
+
+		if c.trigger {
+			result.ShieldTriggers++
+		}
+
+	}
+
+	result.Civilizations = sortedCounts(civs)
+	result.Families = sortedCounts(families)
+	result.TriggerInShield = atLeastOne(len(cards), result.ShieldTriggers, rules.StartingShields)
+
+	for _, turn := range analysisTurns {
+
+		first := rules.StartingHand + turn
+
+		// The player going first might skip their first draw
+		if rules.SkipFirstDraw {
+			first--
+		}
+
+		result.Castable = append(result.Castable, castableChance{
+			Turn:   turn,
+			First:  castableOnTurn(cards, turn, first),
+			Second: castableOnTurn(cards, turn, rules.StartingHand+turn),
+		})
+
+	}
+
+	return result
+
+}
+
+// sortedCounts returns the counts ordered from the most to the least common
+func sortedCounts(counts map[string]int) []countEntry {
+
+	result := make([]countEntry, 0)
+
+	for name, count := range counts {
+		result = append(result, countEntry{Name: name, Count: count})
+	}
+
+	sort.Slice(result, func(i int, j int) bool {
+		if result[i].Count != result[j].Count {
+			return result[i].Count > result[j].Count
+		}
+		return result[i].Name < result[j].Name
+	})
+
+	return result
+
+}
+
+// binomial returns the number of ways to choose k out of n
+func binomial(n int, k int) float64 {
+
+	if k < 0 || k > n {
+		return 0
+	}
+
+	result := 1.0
+
+	for i := 1; i <= k; i++ {
+		result = result * float64(n-k+i) / float64(i)
+	}
+
+	return result
+
+}
+
+// atLeastOne returns the chance to have at least one of the successes
+// in a sample drawn from the population without replacement
+func atLeastOne(population int, successes int, sample int) float64 {
+
+	if population < 1 || sample > population {
+		return 0
+	}
+
+	return 1 - binomial(population-successes, sample)/binomial(population, sample)
+
+}
+
+// castableOnTurn returns the chance that the cards seen by the given turn contain a card costing
+// at most the turn's mana, together with another card of its civilization to charge as mana.
+// The chance is the sum of the multivariate hypergeometric probabilities of every way to draw
+// the seen cards from the groups of cheap and expensive cards of each civilization
+func castableOnTurn(cards []analysisCard, turn int, seen int) float64 {
+
+	if seen > len(cards) {
+		return 0
+	}
+
+	cheap := make(map[string]int)
+	expensive := make(map[string]int)
+	civs := make([]string, 0)
+
+	for _, c := range cards {
+
+		if cheap[c.civ] == 0 && expensive[c.civ] == 0 {
+			civs = append(civs, c.civ)
+		}
+
+		if c.cost <= turn {
+			cheap[c.civ]++
+		} else {
+			expensive[c.civ]++
+		}
+
+	}
+
+	total := binomial(len(cards), seen)
+	failure := 0.0
+
+	// Every civilization contributes the number of its cheap and expensive cards that were seen
+	var enumerate func(i int, remaining int, ways float64)
+	enumerate = func(i int, remaining int, ways float64) {
+
+		if i == len(civs) {
+			if remaining == 0 {
+				failure += ways
+			}
+			return
+		}
+
+		civ := civs[i]
+
+		for a := 0; a <= cheap[civ] && a <= remaining; a++ {
+			for b := 0; b <= expensive[civ] && a+b <= remaining; b++ {
+
+				// A cheap card needs a second card of its civilization to be castable
+				if a >= 1 && a+b >= 2 {
+					continue
+				}
+
+				enumerate(i+1, remaining-a-b, ways*binomial(cheap[civ], a)*binomial(expensive[civ], b))
+
+			}
+		}
+
+	}
+
+	enumerate(0, seen, 1)
+
+	return 1 - failure/total
+
+}
+
+// DeckAnalysisHandler returns the analysis of a deck the user owns or that is public or standard,
+// for the rule profile given in the rules query parameter or the default rules
+func DeckAnalysisHandler(c *gin.Context) {
+
+	rules, ok := match.GetRules(c.Query("rules"))
+	if !ok {
+		c.JSON(400, bson.M{"message": "The specified rules do not exist"})
+		return
+	}
+
+	deck, err := findReadableDeck(c, c.Param("uid"))
+	if err != nil {
+		c.Status(404)
+		return
+	}
+
+	c.JSON(200, AnalyzeDeck(deck.Cards, rules))
+
+}
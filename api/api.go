@@ -27,7 +27,7 @@ func Start(port string) {
 		c.Writer.Header().Set("Access-Control-Allow-Origin", "*")
 		c.Writer.Header().Set("Access-Control-Allow-Credentials", "true")
 		c.Writer.Header().Set("Access-Control-Allow-Headers", "Content-Type, Content-Length, Accept-Encoding, X-CSRF-Token, Authorization, accept, origin, Cache-Control, X-Requested-With")
-		c.Writer.Header().Set("Access-Control-Allow-Methods", "POST, OPTIONS, GET, PUT")
+		c.Writer.Header().Set("Access-Control-Allow-Methods", "POST, OPTIONS, GET, PUT, DELETE")
 
 		if c.Request.Method == "OPTIONS" {
 			c.AbortWithStatus(204)
@@ -42,9 +42,32 @@ func Start(port string) {
 	r.POST("/api/auth/signin", SigninHandler)
 	r.POST("/api/auth/signup", SignupHandler)
 	r.POST("/api/match", MatchHandler)
+	r.GET("/api/users/:name", ProfileHandler)
+	r.GET("/api/users/:name/history", HistoryHandler)
+	r.GET("/api/users/:name/stats", StatsHandler)
+	r.GET("/api/tournaments", GetTournamentsHandler)
+	r.POST("/api/tournaments", CreateTournamentHandler)
+	r.GET("/api/tournaments/:id", GetTournamentHandler)
+	r.GET("/api/tournaments/:id/standings", StandingsHandler)
+	r.POST("/api/tournaments/:id/register", RegisterTournamentHandler)
+	r.POST("/api/tournaments/:id/checkin", CheckInTournamentHandler)
+	r.POST("/api/tournaments/:id/drop", DropTournamentHandler)
+	r.POST("/api/tournaments/:id/start", StartTournamentHandler)
+	r.POST("/api/tournaments/:id/report", ReportTournamentHandler)
 	r.GET("/api/cards", CardsHandler)
+	r.GET("/api/cards/search", SearchCardsHandler)
+	r.GET("/api/formats", FormatsHandler)
+	r.POST("/api/formats/validate", ValidateDeckHandler)
+	r.GET("/api/public/decks", PublicDecksHandler)
 	r.GET("/api/decks", GetDecksHandler)
 	r.POST("/api/decks", CreateDeckHandler)
+	r.POST("/api/decks/import", ImportDeckHandler)
+	r.GET("/api/decks/:uid/export", ExportDeckHandler)
+	r.POST("/api/decks/decode", DecodeDeckHandler)
+	r.POST("/api/decks/clone", CloneDeckHandler)
+	r.DELETE("/api/decks/:uid", DeleteDeckHandler)
+	r.GET("/api/decks/:uid/code", DeckCodeHandler)
+	r.GET("/api/decks/:uid/analysis", DeckAnalysisHandler)
 	r.GET("/invite/:id", InviteHandler)
 
 	// Because Gin does not provide an easy way to handle requests where the file does not exist
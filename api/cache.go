@@ -2,18 +2,62 @@ package api
 
 import (
 	"duel-masters/game/cards"
+	"duel-masters/game/cnd"
 	"duel-masters/game/match"
 	"sync"
 
 	"github.com/sirupsen/logrus"
 )
 
+// Card types
+const (
+	TypeCreature          = "creature"
+	TypeEvolutionCreature = "evolution_creature"
+	TypeSpell             = "spell"
+)
+
 // CardInfo struct is used for the card database api
 type CardInfo struct {
-	UID          string `json:"uid"`
-	Name         string `json:"name"`
-	Civilization string `json:"civilization"`
-	Set          string `json:"set"`
+	UID          string   `json:"uid"`
+	Name         string   `json:"name"`
+	Civilization string   `json:"civilization"`
+	Set          string   `json:"set"`
+	Type         string   `json:"type"`
+	ManaCost     int      `json:"manaCost"`
+	Power        int      `json:"power"`
+	Family       string   `json:"family"`
+	Keywords     []string `json:"keywords"`
+	Text         []string `json:"text"`
+}
+
+// cardType returns the type of the card based on the markers of its fx
+func cardType(card *match.Card) string {
+
+	if card.HasMarker(cnd.Spell) {
+		return TypeSpell
+	}
+
+	if card.HasMarker(cnd.Evolution) {
+		return TypeEvolutionCreature
+	}
+
+	return TypeCreature
+
+}
+
+// cardKeywords returns the markers of the card's keyword abilities, i.e. all markers but the card type
+func cardKeywords(card *match.Card) []string {
+
+	keywords := make([]string, 0)
+
+	for _, marker := range card.Markers() {
+		if marker != cnd.Creature && marker != cnd.Spell && marker != cnd.Evolution {
+			keywords = append(keywords, marker)
+		}
+	}
+
+	return keywords
+
 }
 
 // Register holds all the card info
@@ -25,18 +69,32 @@ func CreateCardCache() {
 
 	for setID, set := range cards.Sets {
 
-		for uid, c := range *set {
+		for uid := range *set {
 
-			card := &match.Card{}
+			card, err := match.Inspect(uid)
 
-			c(card)
+			if err != nil {
+				logrus.Warn(err)
+				continue
+			}
 
-			register = append(register, CardInfo{
+			info := CardInfo{
 				UID:          uid,
 				Name:         card.Name,
 				Civilization: card.Civ,
 				Set:          setID,
-			})
+				Type:         cardType(card),
+				ManaCost:     card.ManaCost,
+				Keywords:     cardKeywords(card),
+				Text:         card.RulesText(),
+			}
+
+			if info.Type != TypeSpell {
+				info.Power = card.Power
+				info.Family = card.Family
+			}
+
+			register = append(register, info)
 
 		}
 
@@ -67,3 +125,20 @@ func CacheHas(uid string) bool {
 	return false
 
 }
+
+// CacheGet returns the cached info of the card with the specified uid
+func CacheGet(uid string) (CardInfo, bool) {
+
+	mutex.Lock()
+
+	defer mutex.Unlock()
+
+	for _, c := range register {
+		if c.UID == uid {
+			return c, true
+		}
+	}
+
+	return CardInfo{}, false
+
+}
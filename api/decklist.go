@@ -0,0 +1,373 @@
+package api
+
+import (
+	"context"
+	"duel-masters/db"
+	"duel-masters/game/cards"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// Matches lines like "4x Bolshack Dragon", "4 x Bolshack Dragon", "4 Bolshack Dragon" and "Bolshack Dragon"
+var decklistLine = regexp.MustCompile(`^(?:(\d+)\s*[xX]?\s+)?(.+?)$`)
+
+// decklistLineError describes why a line of an imported decklist could not be read
+type decklistLineError struct {
+	Line    int    `json:"line"`
+	Text    string `json:"text"`
+	Message string `json:"message"`
+}
+
+// decklistEntry is a successfully read line of an imported decklist
+type decklistEntry struct {
+	Line    int    `json:"line"`
+	Count   int    `json:"count"`
+	UID     string `json:"uid"`
+	Name    string `json:"name"`
+	Guessed bool   `json:"guessed"`
+}
+
+// normalizeCardName lowercases the name and strips everything but letters and digits
+func normalizeCardName(name string) string {
+
+	var b strings.Builder
+
+	for _, r := range strings.ToLower(name) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+		}
+	}
+
+	return b.String()
+
+}
+
+// levenshtein returns the number of single character edits needed to turn a into b
+func levenshtein(a string, b string) int {
+
+	ra, rb := []rune(a), []rune(b)
+
+	previous := make([]int, len(rb)+1)
+	current := make([]int, len(rb)+1)
+
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+
+		current[0] = i
+
+		for j := 1; j <= len(rb); j++ {
+
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+
+			current[j] = minInt(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+
+		}
+
+		previous, current = current, previous
+
+	}
+
+	return previous[len(rb)]
+
+}
+
+func minInt(values ...int) int {
+
+	result := values[0]
+
+	for _, v := range values[1:] {
+		if v < result {
+			result = v
+		}
+	}
+
+	return result
+
+}
+
+// findCardByName returns the card with the given name. Names that do not match exactly
+// are resolved to the closest card name if it is close enough and not ambiguous
+func findCardByName(name string) (CardInfo, bool, error) {
+
+	normalized := normalizeCardName(name)
+
+	if normalized == "" {
+		return CardInfo{}, false, fmt.Errorf("\"%s\" is not a card name", name)
+	}
+
+	best := make([]CardInfo, 0)
+	bestDistance := -1
+
+	for _, c := range GetCache() {
+
+		cardName := normalizeCardName(c.Name)
+
+		if cardName == normalized {
+			return c, false, nil
+		}
+
+		distance := levenshtein(normalized, cardName)
+
+		// A card name that starts with the given name is as good as a small typo
+		if len(normalized) >= 4 && strings.HasPrefix(cardName, normalized) {
+			distance = 1
+		}
+
+		if bestDistance < 0 || distance < bestDistance {
+			best = []CardInfo{c}
+			bestDistance = distance
+		} else if distance == bestDistance && best[len(best)-1].Name != c.Name {
+			best = append(best, c)
+		}
+
+	}
+
+	if bestDistance < 0 || bestDistance > len(normalized)/4+1 {
+		return CardInfo{}, false, fmt.Errorf("Could not find a card named \"%s\"", name)
+	}
+
+	if len(best) > 1 {
+		return CardInfo{}, false, fmt.Errorf("\"%s\" could be %s or %s", name, best[0].Name, best[1].Name)
+	}
+
+	return best[0], true, nil
+
+}
+
+// ParseDecklist reads a plain text decklist with one card per line.
+// Empty lines and lines starting with # or // are ignored
+func ParseDecklist(text string) ([]string, []decklistEntry, []decklistLineError) {
+
+	cards := make([]string, 0)
+	entries := make([]decklistEntry, 0)
+	errors := make([]decklistLineError, 0)
+
+	for i, line := range strings.Split(text, "\n") {
+
+		line = strings.TrimSpace(line)
+
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, "//") {
+			continue
+		}
+
+		parts := decklistLine.FindStringSubmatch(line)
+
+		if parts == nil {
+			errors = append(errors, decklistLineError{Line: i + 1, Text: line, Message: "Could not read the line"})
+			continue
+		}
+
+		count := 1
+
+		if parts[1] != "" {
+
+			n, err := strconv.Atoi(parts[1])
+
+			if err != nil || n < 1 || n > 50 {
+				errors = append(errors, decklistLineError{Line: i + 1, Text: line, Message: "The number of copies has to be between 1 and 50"})
+				continue
+			}
+
+			count = n
+
+		}
+
+		card, guessed, err := findCardByName(parts[2])
+
+		if err != nil {
+			errors = append(errors, decklistLineError{Line: i + 1, Text: line, Message: err.Error()})
+			continue
+		}
+
+		for n := 0; n < count; n++ {
+			cards = append(cards, card.UID)
+		}
+
+		entries = append(entries, decklistEntry{
+			Line:    i + 1,
+			Count:   count,
+			UID:     card.UID,
+			Name:    card.Name,
+			Guessed: guessed,
+		})
+
+	}
+
+	return cards, entries, errors
+
+}
+
+// FormatDecklist returns the plain text decklist of the cards, one line per card in the order they first appear
+func FormatDecklist(cards []string) string {
+
+	copies := make(map[string]int)
+	order := make([]string, 0)
+
+	for _, uid := range cards {
+
+		if copies[uid] < 1 {
+			order = append(order, uid)
+		}
+
+		copies[uid]++
+
+	}
+
+	var b strings.Builder
+
+	for _, uid := range order {
+
+		name := uid
+
+		if card, ok := CacheGet(uid); ok {
+			name = card.Name
+		}
+
+		fmt.Fprintf(&b, "%dx %s\n", copies[uid], name)
+
+	}
+
+	return b.String()
+
+}
+
+type importDeckBody struct {
+	Text string `json:"text" binding:"required"`
+}
+
+// ImportDeckHandler reads a plain text decklist and returns the resolved cards with an error for each line that could not be read
+func ImportDeckHandler(c *gin.Context) {
+
+	var reqBody importDeckBody
+	if err := c.ShouldBindJSON(&reqBody); err != nil {
+		c.Status(400)
+		return
+	}
+
+	cards, entries, errors := ParseDecklist(reqBody.Text)
+
+	c.JSON(200, bson.M{
+		"cards":   cards,
+		"entries": entries,
+		"errors":  errors,
+	})
+
+}
+
+// findReadableDeck returns the deck with the given uid if the user owns it or if it is public or standard
+func findReadableDeck(c *gin.Context, uid string) (db.Deck, error) {
+
+	filter := bson.M{
+		"uid": uid,
+		"$or": []bson.M{
+			{"public": true},
+			{"standard": true},
+		},
+	}
+
+	if user, err := db.GetUserForToken(c.GetHeader("Authorization")); err == nil {
+		filter["$or"] = append(filter["$or"].([]bson.M), bson.M{"owner": user.UID})
+	}
+
+	var deck db.Deck
+
+	err := db.Collection("decks").FindOne(context.TODO(), filter).Decode(&deck)
+
+	return deck, err
+
+}
+
+// ExportDeckHandler returns the plain text decklist of a deck the user owns or that is public or standard
+func ExportDeckHandler(c *gin.Context) {
+
+	deck, err := findReadableDeck(c, c.Param("uid"))
+	if err != nil {
+		c.Status(404)
+		return
+	}
+
+	c.String(200, FormatDecklist(deck.Cards))
+
+}
+
+// DeckCodeHandler returns the deck code of a deck the user owns or that is public or standard
+func DeckCodeHandler(c *gin.Context) {
+
+	deck, err := findReadableDeck(c, c.Param("uid"))
+	if err != nil {
+		c.Status(404)
+		return
+	}
+
+	code, err := cards.EncodeDeck(deck.Cards)
+
+	if err != nil {
+		c.JSON(400, bson.M{"message": err.Error()})
+		return
+	}
+
+	c.JSON(200, bson.M{"code": code})
+
+}
+
+type decodeDeckBody struct {
+	Code   string `json:"code" binding:"required"`
+	Name   string `json:"name" binding:"max=30"`
+	Public bool   `json:"public"`
+}
+
+// DecodeDeckHandler returns the cards of a deck code. If a name is given
+// the cards are saved as a new deck of the user instead
+func DecodeDeckHandler(c *gin.Context) {
+
+	var reqBody decodeDeckBody
+	if err := c.ShouldBindJSON(&reqBody); err != nil {
+		c.Status(400)
+		return
+	}
+
+	deckCards, err := cards.DecodeDeck(reqBody.Code)
+
+	if err != nil {
+		c.JSON(400, bson.M{"message": err.Error()})
+		return
+	}
+
+	if reqBody.Name == "" {
+		c.JSON(200, bson.M{
+			"cards":    deckCards,
+			"decklist": FormatDecklist(deckCards),
+		})
+		return
+	}
+
+	user, err := db.GetUserForToken(c.GetHeader("Authorization"))
+	if err != nil {
+		c.Status(401)
+		return
+	}
+
+	if !validateDeck(c, "", deckCards) {
+		return
+	}
+
+	deck, ok := insertDeck(c, user, reqBody.Name, reqBody.Public, deckCards)
+	if !ok {
+		return
+	}
+
+	c.JSON(200, deck)
+
+}
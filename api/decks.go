@@ -0,0 +1,218 @@
+package api
+
+import (
+	"context"
+	"duel-masters/db"
+	"duel-masters/game/match"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const publicDecksPageSize = 20
+
+// publicDeck is a public deck as listed in the deck browser
+type publicDeck struct {
+	db.Deck
+	Author        string   `json:"author"`
+	Civilizations []string `json:"civilizations"`
+}
+
+// PublicDecksHandler returns a page of public decks, optionally filtered by
+// the civilization of the cards, the author and a card the deck contains
+func PublicDecksHandler(c *gin.Context) {
+
+	page, ok := pageQuery(c)
+	if !ok {
+		return
+	}
+
+	filters := []bson.M{{"public": true}}
+
+	if civ := c.Query("civilization"); civ != "" {
+
+		uids := make([]string, 0)
+
+		for _, card := range GetCache() {
+			if card.Civilization == civ {
+				uids = append(uids, card.UID)
+			}
+		}
+
+		filters = append(filters, bson.M{"cards": bson.M{"$in": uids}})
+
+	}
+
+	if author := c.Query("author"); author != "" {
+
+		user, err := findUserByName(author)
+		if err != nil {
+			c.JSON(200, []publicDeck{})
+			return
+		}
+
+		filters = append(filters, bson.M{"owner": user.UID})
+
+	}
+
+	if card := c.Query("card"); card != "" {
+
+		if _, ok := CacheGet(card); !ok {
+
+			info, guessed, err := findCardByName(card)
+			if err != nil || guessed {
+				c.JSON(200, []publicDeck{})
+				return
+			}
+
+			card = info.UID
+
+		}
+
+		filters = append(filters, bson.M{"cards": card})
+
+	}
+
+	cur, err := db.Collection("decks").Find(
+		context.TODO(),
+		bson.M{"$and": filters},
+		options.Find().SetSort(bson.M{"_id": -1}).SetSkip(int64(page*publicDecksPageSize)).SetLimit(publicDecksPageSize),
+	)
+
+	if err != nil {
+		logrus.Error(err)
+		c.Status(500)
+		return
+	}
+
+	defer cur.Close(context.TODO())
+
+	decks := make([]publicDeck, 0)
+	owners := make([]string, 0)
+
+	for cur.Next(context.TODO()) {
+
+		var deck db.Deck
+
+		if err := cur.Decode(&deck); err != nil {
+			continue
+		}
+
+		decks = append(decks, publicDeck{Deck: deck, Civilizations: match.DeckCivilizations(deck.Cards)})
+		owners = append(owners, deck.Owner)
+
+	}
+
+	authors := usernames(owners)
+
+	for i := range decks {
+		decks[i].Author = authors[decks[i].Owner]
+	}
+
+	c.JSON(200, decks)
+
+}
+
+// usernames returns a map of the given user uids to their usernames
+func usernames(uids []string) map[string]string {
+
+	result := make(map[string]string)
+
+	cur, err := db.Collection("users").Find(context.TODO(), bson.M{"uid": bson.M{"$in": uids}})
+
+	if err != nil {
+		logrus.Error(err)
+		return result
+	}
+
+	defer cur.Close(context.TODO())
+
+	for cur.Next(context.TODO()) {
+
+		var user db.User
+
+		if err := cur.Decode(&user); err != nil {
+			continue
+		}
+
+		result[user.UID] = user.Username
+
+	}
+
+	return result
+
+}
+
+type cloneDeckBody struct {
+	UID    string `json:"uid" binding:"required"`
+	Format string `json:"format"`
+}
+
+// CloneDeckHandler copies a public or standard deck into the user's decks if it is legal in the given format
+func CloneDeckHandler(c *gin.Context) {
+
+	user, err := db.GetUserForToken(c.GetHeader("Authorization"))
+	if err != nil {
+		c.Status(401)
+		return
+	}
+
+	var reqBody cloneDeckBody
+	if err := c.ShouldBindJSON(&reqBody); err != nil {
+		c.Status(400)
+		return
+	}
+
+	var deck db.Deck
+
+	if err := db.Collection("decks").FindOne(context.TODO(), bson.M{
+		"uid": reqBody.UID,
+		"$or": []bson.M{
+			{"public": true},
+			{"standard": true},
+		},
+	}).Decode(&deck); err != nil {
+		c.Status(404)
+		return
+	}
+
+	if !validateDeck(c, reqBody.Format, deck.Cards) {
+		return
+	}
+
+	clone, ok := insertDeck(c, user, deck.Name, false, deck.Cards)
+	if !ok {
+		return
+	}
+
+	c.JSON(200, clone)
+
+}
+
+// DeleteDeckHandler deletes one of the user's decks
+func DeleteDeckHandler(c *gin.Context) {
+
+	user, err := db.GetUserForToken(c.GetHeader("Authorization"))
+	if err != nil {
+		c.Status(401)
+		return
+	}
+
+	result, err := db.Collection("decks").DeleteOne(context.TODO(), bson.M{"uid": c.Param("uid"), "owner": user.UID})
+
+	if err != nil {
+		logrus.Error(err)
+		c.Status(500)
+		return
+	}
+
+	if result.DeletedCount < 1 {
+		c.Status(404)
+		return
+	}
+
+	c.Status(200)
+
+}
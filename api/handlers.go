@@ -8,6 +8,7 @@ import (
 
 	"duel-masters/db"
 	"duel-masters/game"
+	"duel-masters/game/format"
 	"duel-masters/game/match"
 	"duel-masters/server"
 
@@ -122,6 +123,7 @@ func SignupHandler(c *gin.Context) {
 		Email:       reqBody.Email,
 		Password:    string(hash),
 		Permissions: []string{},
+		Rating:      db.DefaultRating,
 		Sessions: []db.UserSession{
 			session,
 		},
@@ -139,8 +141,13 @@ func SignupHandler(c *gin.Context) {
 }
 
 type matchReqBody struct {
-	Name       string `json:"name" binding:"required,min=3,max=100"`
-	Visibility string `json:"visibility" binding:"required"`
+	Name        string `json:"name" binding:"required,min=3,max=100"`
+	Visibility  string `json:"visibility" binding:"required"`
+	Rules       string `json:"rules"`
+	Format      string `json:"format"`
+	Ranked      bool   `json:"ranked"`
+	BestOf      int    `json:"bestOf"`
+	SwitchDecks bool   `json:"switchDecks"`
 }
 
 // MatchHandler handles creation of new mathes
@@ -163,12 +170,62 @@ func MatchHandler(c *gin.Context) {
 		visible = false
 	}
 
-	m := match.New(reqBody.Name, user.UID, visible)
+	if reqBody.Ranked && !visible {
+		c.JSON(400, bson.M{"message": "Private matches cannot be ranked"})
+		return
+	}
+
+	rules, ok := match.GetRules(reqBody.Rules)
+	if !ok {
+		c.JSON(400, bson.M{"message": "The specified rules do not exist"})
+		return
+	}
+
+	if reqBody.Format != "" {
+
+		if _, ok := format.Get(reqBody.Format); !ok {
+			c.JSON(400, bson.M{"message": "The specified format does not exist"})
+			return
+		}
+
+		rules.Format = reqBody.Format
+
+	}
+
+	if reqBody.BestOf != 0 && reqBody.BestOf != 1 && reqBody.BestOf != 3 {
+		c.JSON(400, bson.M{"message": "A match can only be a single game or a best-of-three set"})
+		return
+	}
+
+	m := match.New(reqBody.Name, user.UID, visible, rules)
+	m.Ranked = reqBody.Ranked
+
+	if reqBody.BestOf == 3 {
+		m.Set = match.NewSet(reqBody.BestOf, reqBody.SwitchDecks)
+	}
 
 	c.JSON(200, m)
 
 }
 
+// ProfileHandler returns the public profile of a user
+func ProfileHandler(c *gin.Context) {
+
+	user, err := findUserByName(c.Param("name"))
+	if err != nil {
+		c.Status(404)
+		return
+	}
+
+	c.JSON(200, bson.M{
+		"username":    user.Username,
+		"color":       user.Color,
+		"rating":      user.CurrentRating(),
+		"rankedGames": user.RankedGames,
+	})
+
+}
+
 var upgrader = websocket.Upgrader{
 	ReadBufferSize:  1024,
 	WriteBufferSize: 1024,
@@ -186,6 +243,10 @@ func WS(c *gin.Context) {
 
 		hub = game.GetLobby()
 
+	} else if hubID == "queue" {
+
+		hub = game.GetQueue()
+
 	} else {
 
 		m, err := match.Find(hubID)
@@ -264,6 +325,7 @@ type createDeckBody struct {
 	Cards  []string `json:"cards" binding:"required"`
 	UID    string   `json:"uid"`
 	Public bool     `json:"public"`
+	Format string   `json:"format"`
 }
 
 // CreateDeckHandler handles creating/editing decks
@@ -281,50 +343,17 @@ func CreateDeckHandler(c *gin.Context) {
 		return
 	}
 
-	if len(reqBody.Cards) < 40 || len(reqBody.Cards) > 50 {
-		c.Status(400)
+	if !validateDeck(c, reqBody.Format, reqBody.Cards) {
 		return
 	}
 
-	for _, cuid := range reqBody.Cards {
-		if !CacheHas(cuid) {
-			c.Status(400)
-			return
-		}
-	}
-
 	collection := db.Collection("decks")
 
 	if len(reqBody.UID) < 1 {
 
 		// New deck
 
-		decksCount, err := collection.CountDocuments(context.TODO(), bson.M{"owner": user.UID})
-
-		if err != nil {
-			logrus.Error(err)
-			c.Status(500)
-			return
-		}
-
-		if decksCount >= 15 {
-			c.Status(403)
-			return
-		}
-
-		deck := db.Deck{
-			UID:      uuid.New().String(),
-			Owner:    user.UID,
-			Name:     reqBody.Name,
-			Public:   reqBody.Public,
-			Standard: false,
-			Cards:    reqBody.Cards,
-		}
-
-		_, err = collection.InsertOne(context.TODO(), deck)
-
-		if err != nil {
-			c.Status(500)
+		if _, ok := insertDeck(c, user, reqBody.Name, reqBody.Public, reqBody.Cards); !ok {
 			return
 		}
 
@@ -350,6 +379,65 @@ func CreateDeckHandler(c *gin.Context) {
 
 }
 
+// validateDeck writes a validation report and returns false if the cards are not a legal deck in the format
+func validateDeck(c *gin.Context, formatName string, cards []string) bool {
+
+	f, ok := format.Get(formatName)
+	if !ok {
+		c.JSON(400, bson.M{"message": "The specified format does not exist"})
+		return false
+	}
+
+	if violations := f.Validate(cards); len(violations) > 0 {
+		c.JSON(400, bson.M{
+			"message":    fmt.Sprintf("The deck is not legal in the %s format", f.Name),
+			"violations": violations,
+		})
+		return false
+	}
+
+	return true
+
+}
+
+// insertDeck stores a new deck for the user, or writes an error and returns false if the user has too many decks
+func insertDeck(c *gin.Context, user db.User, name string, public bool, cards []string) (db.Deck, bool) {
+
+	collection := db.Collection("decks")
+
+	decksCount, err := collection.CountDocuments(context.TODO(), bson.M{"owner": user.UID})
+
+	if err != nil {
+		logrus.Error(err)
+		c.Status(500)
+		return db.Deck{}, false
+	}
+
+	if decksCount >= 15 {
+		c.Status(403)
+		return db.Deck{}, false
+	}
+
+	deck := db.Deck{
+		UID:      uuid.New().String(),
+		Owner:    user.UID,
+		Name:     name,
+		Public:   public,
+		Standard: false,
+		Cards:    cards,
+	}
+
+	_, err = collection.InsertOne(context.TODO(), deck)
+
+	if err != nil {
+		c.Status(500)
+		return db.Deck{}, false
+	}
+
+	return deck, true
+
+}
+
 // InviteHandler handles duel invitations
 func InviteHandler(c *gin.Context) {
 
@@ -431,3 +519,39 @@ func InviteHandler(c *gin.Context) {
 
 	c.Data(200, "text/html; charset=utf-8", []byte(res))
 }
+
+// FormatsHandler returns the formats decks can be validated against
+func FormatsHandler(c *gin.Context) {
+	c.JSON(200, format.Formats)
+}
+
+type validateDeckBody struct {
+	Cards  []string `json:"cards" binding:"required"`
+	Format string   `json:"format"`
+}
+
+// ValidateDeckHandler returns every rule of a format the given cards violate
+func ValidateDeckHandler(c *gin.Context) {
+
+	var reqBody validateDeckBody
+
+	if err := c.ShouldBindJSON(&reqBody); err != nil {
+		c.Status(400)
+		return
+	}
+
+	f, ok := format.Get(reqBody.Format)
+	if !ok {
+		c.JSON(400, bson.M{"message": "The specified format does not exist"})
+		return
+	}
+
+	violations := f.Validate(reqBody.Cards)
+
+	c.JSON(200, bson.M{
+		"format":     f.Name,
+		"legal":      len(violations) < 1,
+		"violations": violations,
+	})
+
+}
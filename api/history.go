@@ -0,0 +1,198 @@
+package api
+
+import (
+	"context"
+	"duel-masters/db"
+	"regexp"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const historyPageSize = 20
+
+// maxPage is the highest page paginated endpoints accept, so the offset of a page cannot overflow
+const maxPage = 10000
+
+// winRate holds the number of games and wins of a user in a given category
+type winRate struct {
+	Name    string  `json:"name"`
+	Games   int     `json:"games"`
+	Wins    int     `json:"wins"`
+	Losses  int     `json:"losses"`
+	WinRate float64 `json:"winRate"`
+}
+
+func (w *winRate) add(won bool) {
+
+	w.Games++
+
+	if won {
+		w.Wins++
+	} else {
+		w.Losses++
+	}
+
+	w.WinRate = float64(w.Wins) / float64(w.Games)
+
+}
+
+// findUserByName returns the user with the given username, ignoring case
+func findUserByName(name string) (db.User, error) {
+
+	var user db.User
+
+	err := db.Collection("users").FindOne(context.TODO(), bson.M{"username": primitive.Regex{Pattern: "^" + regexp.QuoteMeta(name) + "$", Options: "i"}}).Decode(&user)
+
+	return user, err
+
+}
+
+// pageQuery returns the page query parameter, or writes an error and returns false if it is not a valid page
+func pageQuery(c *gin.Context) (int, bool) {
+
+	page, err := strconv.Atoi(c.DefaultQuery("page", "0"))
+	if err != nil || page < 0 || page > maxPage {
+		c.Status(400)
+		return 0, false
+	}
+
+	return page, true
+
+}
+
+// HistoryHandler returns a page of the user's finished matches, most recent first
+func HistoryHandler(c *gin.Context) {
+
+	user, err := findUserByName(c.Param("name"))
+	if err != nil {
+		c.Status(404)
+		return
+	}
+
+	page, ok := pageQuery(c)
+	if !ok {
+		return
+	}
+
+	cur, err := db.Collection("matches").Find(
+		context.TODO(),
+		bson.M{"players.uid": user.UID},
+		options.Find().SetSort(bson.M{"ended": -1}).SetSkip(int64(page*historyPageSize)).SetLimit(historyPageSize),
+	)
+
+	if err != nil {
+		logrus.Error(err)
+		c.Status(500)
+		return
+	}
+
+	defer cur.Close(context.TODO())
+
+	matches := make([]db.Match, 0)
+
+	for cur.Next(context.TODO()) {
+
+		var match db.Match
+
+		if err := cur.Decode(&match); err != nil {
+			continue
+		}
+
+		matches = append(matches, match)
+
+	}
+
+	c.JSON(200, matches)
+
+}
+
+// StatsHandler returns the user's win rate overall, per deck and per civilization
+func StatsHandler(c *gin.Context) {
+
+	user, err := findUserByName(c.Param("name"))
+	if err != nil {
+		c.Status(404)
+		return
+	}
+
+	cur, err := db.Collection("matches").Find(context.TODO(), bson.M{"players.uid": user.UID})
+
+	if err != nil {
+		logrus.Error(err)
+		c.Status(500)
+		return
+	}
+
+	defer cur.Close(context.TODO())
+
+	overall := &winRate{Name: user.Username}
+	decks := make(map[string]*winRate)
+	civs := make(map[string]*winRate)
+
+	deckOrder := make([]string, 0)
+	civOrder := make([]string, 0)
+
+	for cur.Next(context.TODO()) {
+
+		var match db.Match
+
+		if err := cur.Decode(&match); err != nil {
+			continue
+		}
+
+		won := match.Winner == user.UID
+
+		overall.add(won)
+
+		for _, player := range match.Players {
+
+			if player.UID != user.UID {
+				continue
+			}
+
+			if _, ok := decks[player.Deck]; !ok {
+				decks[player.Deck] = &winRate{Name: player.DeckName}
+				deckOrder = append(deckOrder, player.Deck)
+			}
+
+			decks[player.Deck].add(won)
+
+			for _, civ := range player.Civilizations {
+
+				if _, ok := civs[civ]; !ok {
+					civs[civ] = &winRate{Name: civ}
+					civOrder = append(civOrder, civ)
+				}
+
+				civs[civ].add(won)
+
+			}
+
+		}
+
+	}
+
+	perDeck := make([]bson.M, 0)
+	for _, uid := range deckOrder {
+		perDeck = append(perDeck, bson.M{"deck": uid, "stats": decks[uid]})
+	}
+
+	perCiv := make([]*winRate, 0)
+	for _, civ := range civOrder {
+		perCiv = append(perCiv, civs[civ])
+	}
+
+	c.JSON(200, bson.M{
+		"overall":       overall,
+		"decks":         perDeck,
+		"civilizations": perCiv,
+		"rating":        user.CurrentRating(),
+		"rankedGames":   user.RankedGames,
+	})
+
+}
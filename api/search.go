@@ -0,0 +1,555 @@
+package api
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+const (
+	searchPageSize    = 50
+	maxSearchPageSize = 200
+)
+
+// cardQuery is a parsed search query that can be evaluated against a card
+type cardQuery interface {
+	match(card CardInfo) bool
+}
+
+type andQuery []cardQuery
+
+func (q andQuery) match(card CardInfo) bool {
+	for _, sub := range q {
+		if !sub.match(card) {
+			return false
+		}
+	}
+	return true
+}
+
+type orQuery []cardQuery
+
+func (q orQuery) match(card CardInfo) bool {
+	for _, sub := range q {
+		if sub.match(card) {
+			return true
+		}
+	}
+	return false
+}
+
+type notQuery struct {
+	query cardQuery
+}
+
+func (q notQuery) match(card CardInfo) bool {
+	return !q.query.match(card)
+}
+
+// termQuery compares a single field of the card with a value, e.g. cost<=3
+type termQuery struct {
+	field string
+	op    string
+	value string
+}
+
+// Operators of search terms, longest first so that <= is not read as <
+var searchOperators = []string{"!=", "<=", ">=", ":", "=", "<", ">"}
+
+// Aliases of the fields that can be searched
+var searchFields = map[string]string{
+	"name":         "name",
+	"n":            "name",
+	"civ":          "civ",
+	"civilization": "civ",
+	"c":            "civ",
+	"cost":         "cost",
+	"mana":         "cost",
+	"type":         "type",
+	"t":            "type",
+	"family":       "family",
+	"race":         "family",
+	"power":        "power",
+	"pow":          "power",
+	"has":          "has",
+	"keyword":      "has",
+	"set":          "set",
+	"text":         "text",
+	"o":            "text",
+}
+
+func (q termQuery) match(card CardInfo) bool {
+
+	var result bool
+
+	switch q.field {
+	case "cost":
+		result = compareNumber(card.ManaCost, q.op, q.value)
+	case "power":
+		result = card.Type != TypeSpell && compareNumber(card.Power, q.op, q.value)
+	case "name":
+		result = containsFold(card.Name, q.value)
+	case "family":
+		result = card.Family != "" && containsFold(card.Family, q.value)
+	case "civ":
+		result = strings.EqualFold(card.Civilization, q.value)
+	case "set":
+		result = strings.EqualFold(card.Set, q.value)
+	case "type":
+		result = matchesType(card.Type, q.value)
+	case "text":
+		for _, line := range card.Text {
+			if containsFold(line, q.value) {
+				result = true
+			}
+		}
+	case "has":
+		for _, keyword := range card.Keywords {
+			if normalizeCardName(keyword) == normalizeCardName(q.value) {
+				result = true
+			}
+		}
+	}
+
+	if q.op == "!=" {
+		return !result
+	}
+
+	return result
+
+}
+
+// matchesType returns true if the card type matches the searched type, creatures include evolution creatures
+func matchesType(cardType string, value string) bool {
+
+	switch normalizeCardName(value) {
+	case "creature":
+		return cardType == TypeCreature || cardType == TypeEvolutionCreature
+	case "evolution", "evolutioncreature":
+		return cardType == TypeEvolutionCreature
+	case "spell":
+		return cardType == TypeSpell
+	}
+
+	return false
+
+}
+
+func containsFold(s string, substr string) bool {
+	return strings.Contains(strings.ToLower(s), strings.ToLower(substr))
+}
+
+// compareNumber compares the number with the value using the operator, : and = both mean equal
+func compareNumber(n int, op string, value string) bool {
+
+	v, err := strconv.Atoi(value)
+
+	if err != nil {
+		return false
+	}
+
+	switch op {
+	case ":", "=", "!=":
+		return n == v
+	case "<":
+		return n < v
+	case "<=":
+		return n <= v
+	case ">":
+		return n > v
+	case ">=":
+		return n >= v
+	}
+
+	return false
+
+}
+
+// searchToken is a word, a parenthesis or a negation of a search query
+type searchToken struct {
+	text   string
+	quoted bool
+	pos    int
+}
+
+// tokenizeSearch splits the query into words and parentheses. Quoted parts are kept together
+func tokenizeSearch(query string) ([]searchToken, error) {
+
+	tokens := make([]searchToken, 0)
+	runes := []rune(query)
+
+	for i := 0; i < len(runes); {
+
+		r := runes[i]
+
+		if unicode.IsSpace(r) {
+			i++
+			continue
+		}
+
+		if r == '(' || r == ')' {
+			tokens = append(tokens, searchToken{text: string(r), pos: i})
+			i++
+			continue
+		}
+
+		// A dash in front of a word negates it
+		if r == '-' && i+1 < len(runes) && !unicode.IsSpace(runes[i+1]) {
+			tokens = append(tokens, searchToken{text: "-", pos: i})
+			i++
+			continue
+		}
+
+		start := i
+		quoted := false
+
+		var b strings.Builder
+
+		for i < len(runes) && !unicode.IsSpace(runes[i]) && runes[i] != '(' && runes[i] != ')' {
+
+			if runes[i] == '"' {
+
+				end := i + 1
+				for end < len(runes) && runes[end] != '"' {
+					end++
+				}
+
+				if end >= len(runes) {
+					return nil, fmt.Errorf("Missing closing quote for the quote at position %d", i+1)
+				}
+
+				b.WriteString(string(runes[i+1 : end]))
+				quoted = true
+				i = end + 1
+				continue
+
+			}
+
+			b.WriteRune(runes[i])
+			i++
+
+		}
+
+		tokens = append(tokens, searchToken{text: b.String(), quoted: quoted, pos: start})
+
+	}
+
+	return tokens, nil
+
+}
+
+// searchParser is a recursive descent parser for search queries:
+//
+//	or   = and { "OR" and }
+//	and  = not { [ "AND" ] not }
+//	not  = ( "NOT" | "-" ) not | "(" or ")" | term
+//	term = field operator value | word
+type searchParser struct {
+	tokens []searchToken
+	pos    int
+}
+
+// ParseCardQuery parses a search query like `civ:fire cost<=3 type:creature has:blocker`
+func ParseCardQuery(query string) (cardQuery, error) {
+
+	tokens, err := tokenizeSearch(query)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tokens) < 1 {
+		return andQuery{}, nil
+	}
+
+	p := &searchParser{tokens: tokens}
+
+	q, err := p.parseOr()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if p.pos < len(p.tokens) {
+		return nil, fmt.Errorf("Unexpected \"%s\" at position %d", p.tokens[p.pos].text, p.tokens[p.pos].pos+1)
+	}
+
+	return q, nil
+
+}
+
+// keyword returns true if the next token is the given unquoted keyword, ignoring case
+func (p *searchParser) keyword(word string) bool {
+	return p.pos < len(p.tokens) && !p.tokens[p.pos].quoted && strings.EqualFold(p.tokens[p.pos].text, word)
+}
+
+func (p *searchParser) parseOr() (cardQuery, error) {
+
+	first, err := p.parseAnd()
+
+	if err != nil {
+		return nil, err
+	}
+
+	result := orQuery{first}
+
+	for p.keyword("or") {
+
+		p.pos++
+
+		next, err := p.parseAnd()
+
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, next)
+
+	}
+
+	if len(result) == 1 {
+		return first, nil
+	}
+
+	return result, nil
+
+}
+
+func (p *searchParser) parseAnd() (cardQuery, error) {
+
+	result := andQuery{}
+
+	for p.pos < len(p.tokens) && !p.keyword("or") && p.tokens[p.pos].text != ")" {
+
+		if p.keyword("and") {
+			p.pos++
+		}
+
+		next, err := p.parseNot()
+
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, next)
+
+	}
+
+	if len(result) < 1 {
+		return nil, p.unexpected()
+	}
+
+	if len(result) == 1 {
+		return result[0], nil
+	}
+
+	return result, nil
+
+}
+
+func (p *searchParser) parseNot() (cardQuery, error) {
+
+	if p.pos >= len(p.tokens) {
+		return nil, p.unexpected()
+	}
+
+	token := p.tokens[p.pos]
+
+	if p.keyword("not") || (token.text == "-" && !token.quoted) {
+
+		p.pos++
+
+		q, err := p.parseNot()
+
+		if err != nil {
+			return nil, err
+		}
+
+		return notQuery{q}, nil
+
+	}
+
+	if token.text == "(" && !token.quoted {
+
+		p.pos++
+
+		q, err := p.parseOr()
+
+		if err != nil {
+			return nil, err
+		}
+
+		if p.pos >= len(p.tokens) || p.tokens[p.pos].text != ")" {
+			return nil, fmt.Errorf("Missing closing parenthesis for the parenthesis at position %d", token.pos+1)
+		}
+
+		p.pos++
+
+		return q, nil
+
+	}
+
+	p.pos++
+
+	return parseSearchTerm(token)
+
+}
+
+// unexpected returns an error for the current token or the end of the query
+func (p *searchParser) unexpected() error {
+
+	if p.pos >= len(p.tokens) {
+		return fmt.Errorf("Unexpected end of the query")
+	}
+
+	return fmt.Errorf("Unexpected \"%s\" at position %d", p.tokens[p.pos].text, p.tokens[p.pos].pos+1)
+
+}
+
+// parseSearchTerm reads a term like cost<=3. Words without a field search the card names
+func parseSearchTerm(token searchToken) (cardQuery, error) {
+
+	text := token.text
+
+	for i, r := range text {
+
+		if !unicode.IsLetter(r) {
+
+			for _, op := range searchOperators {
+
+				if !strings.HasPrefix(text[i:], op) {
+					continue
+				}
+
+				field, ok := searchFields[strings.ToLower(text[:i])]
+
+				if !ok {
+					return nil, fmt.Errorf("Unknown field \"%s\" at position %d", text[:i], token.pos+1)
+				}
+
+				value := text[i+len(op):]
+
+				if value == "" {
+					return nil, fmt.Errorf("Missing value for \"%s\" at position %d", text[:i], token.pos+1)
+				}
+
+				if field == "cost" || field == "power" {
+					if _, err := strconv.Atoi(value); err != nil {
+						return nil, fmt.Errorf("\"%s\" is not a number at position %d", value, token.pos+1)
+					}
+				} else if op != ":" && op != "=" && op != "!=" {
+					return nil, fmt.Errorf("\"%s\" cannot be used with %s at position %d", op, text[:i], token.pos+1)
+				}
+
+				return termQuery{field: field, op: op, value: value}, nil
+
+			}
+
+			break
+
+		}
+
+	}
+
+	return termQuery{field: "name", op: ":", value: text}, nil
+
+}
+
+// sortCards sorts the cards by the given field, cards that are equal are sorted by name
+func sortCards(cards []CardInfo, field string, descending bool) error {
+
+	var less func(a CardInfo, b CardInfo) int
+
+	switch field {
+	case "", "name":
+		less = func(a CardInfo, b CardInfo) int { return strings.Compare(a.Name, b.Name) }
+	case "cost":
+		less = func(a CardInfo, b CardInfo) int { return a.ManaCost - b.ManaCost }
+	case "power":
+		less = func(a CardInfo, b CardInfo) int { return a.Power - b.Power }
+	case "civ", "civilization":
+		less = func(a CardInfo, b CardInfo) int { return strings.Compare(a.Civilization, b.Civilization) }
+	case "set":
+		less = func(a CardInfo, b CardInfo) int { return strings.Compare(a.Set, b.Set) }
+	default:
+		return fmt.Errorf("Cannot sort by \"%s\"", field)
+	}
+
+	sort.SliceStable(cards, func(i int, j int) bool {
+
+		c := less(cards[i], cards[j])
+
+		if c == 0 {
+			return cards[i].Name < cards[j].Name
+		}
+
+		if descending {
+			return c > 0
+		}
+
+		return c < 0
+
+	})
+
+	return nil
+
+}
+
+// SearchCardsHandler returns a page of the cards matching the search query
+func SearchCardsHandler(c *gin.Context) {
+
+	query, err := ParseCardQuery(c.Query("q"))
+
+	if err != nil {
+		c.JSON(400, bson.M{"message": err.Error()})
+		return
+	}
+
+	page, ok := pageQuery(c)
+	if !ok {
+		return
+	}
+
+	pageSize, err := strconv.Atoi(c.DefaultQuery("pageSize", strconv.Itoa(searchPageSize)))
+	if err != nil || pageSize < 1 || pageSize > maxSearchPageSize {
+		c.Status(400)
+		return
+	}
+
+	cards := make([]CardInfo, 0)
+
+	for _, card := range GetCache() {
+		if query.match(card) {
+			cards = append(cards, card)
+		}
+	}
+
+	if err := sortCards(cards, c.Query("sort"), c.Query("order") == "desc"); err != nil {
+		c.JSON(400, bson.M{"message": err.Error()})
+		return
+	}
+
+	total := len(cards)
+
+	start := page * pageSize
+	if start > total {
+		start = total
+	}
+
+	end := start + pageSize
+	if end > total {
+		end = total
+	}
+
+	c.JSON(200, bson.M{
+		"total": total,
+		"page":  page,
+		"cards": cards[start:end],
+	})
+
+}
@@ -0,0 +1,220 @@
+package api
+
+import (
+	"duel-masters/db"
+	"duel-masters/game/tournament"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+const tournamentListSize = 50
+
+// tournamentError writes the response for an error returned by the tournament package
+func tournamentError(c *gin.Context, err error) {
+
+	switch err {
+	case tournament.ErrNotFound:
+		c.Status(404)
+	case tournament.ErrNotOwner:
+		c.JSON(403, bson.M{"message": err.Error()})
+	default:
+		c.JSON(400, bson.M{"message": err.Error()})
+	}
+
+}
+
+// GetTournamentsHandler returns the most recently created tournaments
+func GetTournamentsHandler(c *gin.Context) {
+
+	tournaments, err := tournament.List(tournamentListSize)
+
+	if err != nil {
+		logrus.Error(err)
+		c.Status(500)
+		return
+	}
+
+	c.JSON(200, tournaments)
+
+}
+
+type createTournamentBody struct {
+	Name        string `json:"name" binding:"required,min=3,max=100"`
+	Rules       string `json:"rules"`
+	SwissRounds int    `json:"swissRounds"`
+	TopCut      int    `json:"topCut"`
+}
+
+// CreateTournamentHandler handles creation of new tournaments
+func CreateTournamentHandler(c *gin.Context) {
+
+	user, err := db.GetUserForToken(c.GetHeader("Authorization"))
+	if err != nil {
+		c.Status(401)
+		return
+	}
+
+	var reqBody createTournamentBody
+	if err := c.ShouldBindJSON(&reqBody); err != nil {
+		c.Status(400)
+		return
+	}
+
+	t, err := tournament.Create(user, reqBody.Name, reqBody.Rules, reqBody.SwissRounds, reqBody.TopCut)
+
+	if err != nil {
+		tournamentError(c, err)
+		return
+	}
+
+	c.JSON(200, t)
+
+}
+
+// GetTournamentHandler returns a tournament with its players and rounds
+func GetTournamentHandler(c *gin.Context) {
+
+	t, err := tournament.Get(c.Param("id"))
+
+	if err != nil {
+		tournamentError(c, err)
+		return
+	}
+
+	c.JSON(200, t)
+
+}
+
+// StandingsHandler returns the standings of a tournament's swiss rounds
+func StandingsHandler(c *gin.Context) {
+
+	t, err := tournament.Get(c.Param("id"))
+
+	if err != nil {
+		tournamentError(c, err)
+		return
+	}
+
+	c.JSON(200, tournament.Standings(&t))
+
+}
+
+type registerTournamentBody struct {
+	Deck string `json:"deck" binding:"required"`
+}
+
+// RegisterTournamentHandler registers the user for a tournament
+func RegisterTournamentHandler(c *gin.Context) {
+
+	user, err := db.GetUserForToken(c.GetHeader("Authorization"))
+	if err != nil {
+		c.Status(401)
+		return
+	}
+
+	var reqBody registerTournamentBody
+	if err := c.ShouldBindJSON(&reqBody); err != nil {
+		c.Status(400)
+		return
+	}
+
+	if err := tournament.Register(c.Param("id"), user, reqBody.Deck); err != nil {
+		tournamentError(c, err)
+		return
+	}
+
+	c.Status(200)
+
+}
+
+// CheckInTournamentHandler checks the user in for a tournament
+func CheckInTournamentHandler(c *gin.Context) {
+
+	user, err := db.GetUserForToken(c.GetHeader("Authorization"))
+	if err != nil {
+		c.Status(401)
+		return
+	}
+
+	if err := tournament.CheckIn(c.Param("id"), user); err != nil {
+		tournamentError(c, err)
+		return
+	}
+
+	c.Status(200)
+
+}
+
+// DropTournamentHandler removes the user from a tournament
+func DropTournamentHandler(c *gin.Context) {
+
+	user, err := db.GetUserForToken(c.GetHeader("Authorization"))
+	if err != nil {
+		c.Status(401)
+		return
+	}
+
+	if err := tournament.Drop(c.Param("id"), user); err != nil {
+		tournamentError(c, err)
+		return
+	}
+
+	c.Status(200)
+
+}
+
+// StartTournamentHandler starts a tournament, only the organizer can start it
+func StartTournamentHandler(c *gin.Context) {
+
+	user, err := db.GetUserForToken(c.GetHeader("Authorization"))
+	if err != nil {
+		c.Status(401)
+		return
+	}
+
+	if err := tournament.Start(c.Param("id"), user); err != nil {
+		tournamentError(c, err)
+		return
+	}
+
+	t, err := tournament.Get(c.Param("id"))
+
+	if err != nil {
+		tournamentError(c, err)
+		return
+	}
+
+	c.JSON(200, t)
+
+}
+
+type reportTournamentBody struct {
+	Match  string `json:"match" binding:"required"`
+	Winner string `json:"winner" binding:"required"`
+}
+
+// ReportTournamentHandler lets the organizer record the result of a tournament match
+func ReportTournamentHandler(c *gin.Context) {
+
+	user, err := db.GetUserForToken(c.GetHeader("Authorization"))
+	if err != nil {
+		c.Status(401)
+		return
+	}
+
+	var reqBody reportTournamentBody
+	if err := c.ShouldBindJSON(&reqBody); err != nil {
+		c.Status(400)
+		return
+	}
+
+	if err := tournament.ReportResult(c.Param("id"), user, reqBody.Match, reqBody.Winner); err != nil {
+		tournamentError(c, err)
+		return
+	}
+
+	c.Status(200)
+
+}
@@ -0,0 +1,173 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"sort"
+
+	"duel-masters/game/cards"
+	"duel-masters/game/civ"
+	"duel-masters/game/cnd"
+	"duel-masters/game/match"
+)
+
+var civilizations = []string{civ.Fire, civ.Water, civ.Nature, civ.Light, civ.Darkness}
+
+// Checks every registered card constructor and exits with 1 if any card has errors
+func main() {
+
+	setIDs := make([]string, 0)
+
+	for setID := range cards.Sets {
+		setIDs = append(setIDs, setID)
+	}
+
+	sort.Strings(setIDs)
+
+	errors := 0
+
+	// Set of every uid, match.AddCard silently replaces the constructor of a duplicate
+	seen := make(map[string]string)
+
+	for _, setID := range setIDs {
+
+		set := *cards.Sets[setID]
+
+		// Set of the uids in the deck code order of the set
+		codes := make(map[string]bool)
+
+		for _, uid := range cards.CodeOrder[setID] {
+
+			if _, ok := codes[uid]; ok {
+				fmt.Printf("%s: %s is in the deck code order more than once\n", setID, uid)
+				errors++
+			}
+
+			if _, ok := set[uid]; !ok {
+				fmt.Printf("%s: %s is in the deck code order but not in the set\n", setID, uid)
+				errors++
+			}
+
+			codes[uid] = true
+
+		}
+
+		uids := make([]string, 0)
+
+		for uid := range set {
+			uids = append(uids, uid)
+		}
+
+		sort.Strings(uids)
+
+		for _, uid := range uids {
+
+			ctor := set[uid]
+
+			problems := checkCard(uid, ctor)
+
+			if other, ok := seen[uid]; ok {
+				problems = append(problems, fmt.Sprintf("uid is also used in %s", other))
+			}
+
+			if _, ok := codes[uid]; !ok {
+				problems = append(problems, "uid is missing from the deck code order")
+			}
+
+			seen[uid] = setID
+
+			if len(problems) < 1 {
+				continue
+			}
+
+			card := match.InspectConstructor(uid, ctor)
+
+			for _, problem := range problems {
+				fmt.Printf("%s: %s (%s): %s\n", setID, card.Name, uid, problem)
+			}
+
+			errors += len(problems)
+
+		}
+
+	}
+
+	if errors > 0 {
+		fmt.Printf("Found %d errors while checking %d cards\n", errors, len(seen))
+		os.Exit(1)
+	}
+
+	fmt.Printf("Checked %d cards, no errors found\n", len(seen))
+
+}
+
+// checkCard returns the problems of the card created by the constructor
+func checkCard(uid string, ctor match.CardConstructor) []string {
+
+	problems := make([]string, 0)
+
+	// A zero card shows which fields the constructor never sets, they would
+	// otherwise carry the defaults of match.NewCard
+	blank := &match.Card{}
+	ctor(blank)
+
+	if blank.Name == "" {
+		problems = append(problems, "name is not set")
+	}
+
+	if blank.Civ == "" {
+		problems = append(problems, "civilization is not set")
+	} else if !contains(civilizations, blank.Civ) {
+		problems = append(problems, fmt.Sprintf("unknown civilization %s", blank.Civ))
+	}
+
+	if blank.ManaCost == 0 {
+		problems = append(problems, "mana cost is not set")
+	}
+
+	if len(blank.ManaRequirement) < 1 {
+		problems = append(problems, "mana requirement is not set")
+	}
+
+	card := match.InspectConstructor(uid, ctor)
+
+	creature := card.HasMarker(cnd.Creature)
+	spell := card.HasMarker(cnd.Spell)
+
+	switch {
+	case creature && spell:
+		problems = append(problems, "card uses both fx.Creature and fx.Spell")
+	case creature:
+		if blank.Family == "" {
+			problems = append(problems, "creature has no family")
+		}
+		if blank.Power < 1 {
+			problems = append(problems, "creature has no power")
+		}
+	case spell:
+		if blank.Power != 0 {
+			problems = append(problems, "spell has power")
+		}
+	default:
+		if blank.Family != "" || blank.Power != 0 {
+			problems = append(problems, "creature is missing fx.Creature")
+		} else {
+			problems = append(problems, "spell is missing fx.Spell")
+		}
+	}
+
+	return problems
+
+}
+
+func contains(values []string, value string) bool {
+
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+
+}
@@ -9,6 +9,7 @@ import (
 	"duel-masters/db"
 	"duel-masters/game"
 	"duel-masters/game/cards"
+	"duel-masters/game/format"
 	"duel-masters/game/match"
 
 	"github.com/sirupsen/logrus"
@@ -23,13 +24,21 @@ func main() {
 
 	logrus.Info("Starting..")
 
-	for _, set := range cards.Sets {
+	for setID, set := range cards.Sets {
 		for uid, ctor := range *set {
+
 			match.AddCard(uid, ctor)
+
+			card := &match.Card{}
+			ctor(card)
+
+			format.AddCard(uid, card.Name, setID)
+
 		}
 	}
 
 	go game.GetLobby().StartTicker()
+	go game.GetQueue().StartTicker()
 
 	api.CreateCardCache()
 
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"sort"
+
+	"duel-masters/game/cards"
+	"duel-masters/game/match"
+)
+
+// Lists every card that has abilities without rules text and exits with 1 if there are any
+func main() {
+
+	for _, set := range cards.Sets {
+		for uid, ctor := range *set {
+			match.AddCard(uid, ctor)
+		}
+	}
+
+	missing := 0
+
+	for _, setID := range cards.SetOrder {
+
+		names := make([]string, 0)
+
+		for uid := range *cards.Sets[setID] {
+
+			card, err := match.Inspect(uid)
+
+			if err != nil {
+				fmt.Println(err)
+				continue
+			}
+
+			if card.MissingRulesText() {
+				names = append(names, fmt.Sprintf("%s (%s)", card.Name, uid))
+			}
+
+		}
+
+		sort.Strings(names)
+
+		for _, name := range names {
+			fmt.Printf("%s: %s\n", setID, name)
+		}
+
+		missing += len(names)
+
+	}
+
+	if missing > 0 {
+		fmt.Printf("%d cards are missing rules text\n", missing)
+		os.Exit(1)
+	}
+
+	fmt.Println("Every card has rules text")
+
+}
@@ -15,9 +15,38 @@ type User struct {
 	Password    string        `json:"-"`
 	Email       string        `json:"email"`
 	Color       string        `json:"color"`
+	Rating      int           `json:"rating"`
+	RankedGames int           `json:"rankedGames"`
 	Sessions    []UserSession `json:"-"`
 }
 
+// MatchPlayer holds information about a player that took part in a finished match
+type MatchPlayer struct {
+	UID           string   `json:"uid"`
+	Username      string   `json:"username"`
+	Deck          string   `json:"deck"`
+	DeckName      string   `json:"deckName"`
+	Civilizations []string `json:"civilizations"`
+	Wins          int      `json:"wins,omitempty"`
+}
+
+// Match struct is the record of a finished match
+type Match struct {
+	UID        string        `json:"uid"`
+	Name       string        `json:"name"`
+	Players    []MatchPlayer `json:"players"`
+	Winner     string        `json:"winner"`
+	Reason     string        `json:"reason"`
+	Turns      int           `json:"turns"`
+	Games      int           `json:"games"`
+	Ranked     bool          `json:"ranked"`
+	Rules      string        `json:"rules"`
+	Tournament string        `json:"tournament,omitempty"`
+	Started    int64         `json:"started"`
+	Ended      int64         `json:"ended"`
+	Duration   int64         `json:"duration"`
+}
+
 // Deck struct is a player deck
 type Deck struct {
 	UID      string   `json:"uid"`
@@ -27,3 +56,42 @@ type Deck struct {
 	Standard bool     `json:"standard"`
 	Cards    []string `json:"cards"`
 }
+
+// TournamentPlayer is a player registered for a tournament
+type TournamentPlayer struct {
+	UID       string `json:"uid"`
+	Username  string `json:"username"`
+	Deck      string `json:"deck"`
+	CheckedIn bool   `json:"checkedIn"`
+	Dropped   bool   `json:"dropped"`
+}
+
+// TournamentPairing is a single game of a tournament round. Player2 is empty for a bye
+type TournamentPairing struct {
+	Player1 string `json:"player1"`
+	Player2 string `json:"player2"`
+	Match   string `json:"match"`
+	Winner  string `json:"winner"`
+}
+
+// TournamentRound holds the pairings of a tournament round
+type TournamentRound struct {
+	Number   int                 `json:"number"`
+	Stage    string              `json:"stage"`
+	Pairings []TournamentPairing `json:"pairings"`
+}
+
+// Tournament struct holds a tournament, its players and all rounds played so far
+type Tournament struct {
+	UID         string             `json:"uid"`
+	Name        string             `json:"name"`
+	Owner       string             `json:"owner"`
+	Rules       string             `json:"rules"`
+	SwissRounds int                `json:"swissRounds"`
+	TopCut      int                `json:"topCut"`
+	Status      string             `json:"status"`
+	Players     []TournamentPlayer `json:"players"`
+	Rounds      []TournamentRound  `json:"rounds"`
+	Winner      string             `json:"winner"`
+	Created     int64              `json:"created"`
+}
@@ -0,0 +1,15 @@
+package db
+
+// DefaultRating is the rating every user starts out with
+const DefaultRating = 1200
+
+// CurrentRating returns the user's rating, or the default rating if the user has not played any ranked matches
+func (u User) CurrentRating() int {
+
+	if u.RankedGames < 1 && u.Rating == 0 {
+		return DefaultRating
+	}
+
+	return u.Rating
+
+}
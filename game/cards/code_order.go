@@ -0,0 +1,143 @@
+package cards
+
+// CodeOrder is the order the cards of each set are indexed in deck codes. It holds the uid of every
+// card of the set and new cards must only ever be appended, or existing deck codes will decode to
+// different cards
+var CodeOrder = map[string][]string{
+	"dm-01": {
+		"015fd6bb-37a9-45cf-bb6b-a5497412b880",
+		"07a0115e-797a-49d8-90bf-9ea6de39978d",
+		"09b218fc-9c5a-48ef-9555-4908932271e9",
+		"0b1e4f56-6342-46db-9faf-882fd1f1f179",
+		"0cc5279e-0a26-41a8-a2a5-f7711120b772",
+		"0e26fe1a-a9d1-4c78-80e9-7f4cc0e4c1c8",
+		"0ec572b0-ffaf-4abd-a540-ba26c98aacc5",
+		"0ffdcae3-9db2-401b-8a82-dfad707b83cd",
+		"10e0e90f-ad7d-4b69-98d5-f01525eb1cdd",
+		"15efe8b0-02c1-439b-8e7c-4548e74f5c33",
+		"162f70fb-33f7-4436-a114-41f255c0ce7e",
+		"18e0e199-7827-4a4c-a37d-3acfa4e500d6",
+		"198ffce7-3d79-420e-9d9b-ebd6421adb6f",
+		"1c5511be-7629-41c5-bf17-4bc810be5472",
+		"1d72eb3e-5185-449a-a16f-391bd2338343",
+		"1ecb54a2-bcbf-4396-bf09-50dfe984e287",
+		"25a2af16-cc42-4f4c-8c3d-59fb3a7ca74b",
+		"2aeae452-5630-4f86-b073-7e9dc07adc43",
+		"2c8ded77-89f3-4625-aa3e-6576b83e0384",
+		"32acfe8b-90fc-4ba9-b6ad-7655c0abee12",
+		"340ec79b-3a4e-4483-ac0e-5dd6b40eb4e1",
+		"35a9315c-2c08-46e0-b96b-daf3e8e996ce",
+		"39090f65-779c-46c9-856c-67303dd5605c",
+		"3b6e6c29-017d-41b9-bf93-186f7963723e",
+		"3e2940f4-5654-4456-bfc2-fa5e43911cfb",
+		"3f331274-f5f8-42e7-9f28-ce637add34d4",
+		"4097a036-a775-4218-9a1d-f57ead85dda6",
+		"41c0664d-1969-487d-bde5-866127c1c49e",
+		"43abeec5-0597-43b3-93cf-766b95d19b5b",
+		"446eaf96-36c8-4093-b4b2-e77e7afb6e3f",
+		"452aead9-2a65-46b1-84b2-383fe99ddc5f",
+		"48c5c29b-2f4e-4a57-86b4-864c6f0dc124",
+		"4b021e6f-39cf-401e-89cf-f164f7c0a797",
+		"4c9acf76-cc52-44c3-9e39-613d744c63c5",
+		"4d3201e8-0d9b-481e-b8e3-86cb90058e20",
+		"5370bad9-1260-455e-8120-ea89badc7eaf",
+		"578ed21b-8ba5-42b2-b662-87a321ee0c7d",
+		"57eeb3c3-2561-4841-a381-2e50d17533d1",
+		"5883180e-d88c-4f24-b17c-f5a837420147",
+		"596f5b72-2502-4120-81f9-9ff9a17271d8",
+		"5cafc789-e730-4472-9a62-8b333b2691e6",
+		"5d3d7052-e5fa-4502-8d31-c72673232317",
+		"5d73062e-acff-47e6-b49a-c0bb1a1762b5",
+		"6161e271-5294-4073-94d2-b9c06f9d8fa3",
+		"616c146e-049f-4720-a225-0a189729ca79",
+		"6663848d-035e-44b6-9d9f-7b236ea5bc43",
+		"68d78fd4-db8a-43a6-8eb6-e1435cfc2959",
+		"6a4270cf-f3be-4c66-8b30-eb2c769065dc",
+		"6cf85053-abaa-4577-b151-86123004980e",
+		"6f2cc530-1228-4b03-9ec0-ba24f6a367bf",
+		"70e6cc2c-c63d-4dd9-9b6e-0713fed174bb",
+		"71d90484-c144-4dcf-ad8e-23e7e55f0f2e",
+		"725a28b7-8c06-4691-93d8-1c6b0dacdba5",
+		"7a6f1c82-a8ac-4646-b3e9-fb8592bdd0a4",
+		"7b22cc2c-3a4a-4f50-9e61-fb9646a762cd",
+		"7b58e8c2-0b1e-4ef5-812f-e667c2092c73",
+		"7f225860-af37-47ac-9b36-1480872576b6",
+		"808ddd60-e8ca-49f0-9baa-57e632f85b28",
+		"84e1b416-c2d5-4ae1-aca0-025651c6aa58",
+		"858b2c1d-5507-46f0-8840-151ee59f760b",
+		"87a102b5-71fd-410a-a8f0-c35182217f08",
+		"90b2ed59-828c-4237-ac2e-b7008a02ad2e",
+		"91db2302-6794-4aa4-b17b-6637d356e9ac",
+		"9430e127-ce64-4572-b386-f1ce3f50e94a",
+		"95bfccf9-91cf-4ab9-8298-c95bc368bf0b",
+		"9781089f-1aa9-4a75-b106-35e9d431e31d",
+		"983e72d7-3f4e-466d-a4e3-06552e392af2",
+		"a2e11f7f-63f4-4357-9e77-5314576dff45",
+		"a3331db6-d3c2-4b3f-9fcc-f4aa9fc2bb52",
+		"a3cf18f0-b04f-45e9-97f7-2a2ead0a1787",
+		"a4adb373-0aec-4fff-997c-3820c7ec528d",
+		"a7eceb07-4f6d-4b2b-8dba-7a3df8f803f7",
+		"a8503655-fdcb-48e2-bfb0-0ad3aae31f0e",
+		"ae66061e-6039-4dee-abf0-51169913bb35",
+		"ae797f95-54b1-48e9-9216-f315b39826bd",
+		"af3bc221-1cc2-4f58-83ea-2673ac2c66c5",
+		"b12f1d66-46ee-49b9-878d-59cc3d515633",
+		"b3ca1944-41a2-4939-ae85-1a73b1fe085f",
+		"b7d11c62-2ab3-439b-b147-ae29d34e9216",
+		"bbc655b3-3676-4cda-9554-e2d465e20b99",
+		"be80f0e8-05b7-4914-916a-f24d5e616ea8",
+		"be8c0d0b-dcab-402c-8e7b-878e35bacca7",
+		"becd0856-fb8b-46fd-a950-b57cc5d17c70",
+		"bee69327-ca6b-455c-b3dc-463fc3284b61",
+		"c05fe45d-690e-4856-bddb-5f46154e57e5",
+		"c1ebdda0-be88-4665-937e-2ef3ada8d378",
+		"c3389188-718d-45bb-8946-0a572d96916b",
+		"c43bc627-9e7a-4686-9d61-789425669b02",
+		"c4839847-e393-47b0-b172-95531aa6d39e",
+		"c5a869f4-a959-4667-a352-92df5369e0b9",
+		"c761c174-87c3-4f4a-ab94-aa837c5ab587",
+		"c782edd9-34ef-47f5-8f16-af2c3b107a36",
+		"c971ff15-5735-4d61-bb16-c805130ca405",
+		"c9b98336-312d-4d08-8add-6b820a88815f",
+		"cc9762c3-515a-4734-a3fe-1e0c4c3b3d71",
+		"cd13f7c2-aa5e-43b8-8811-700f230a5de5",
+		"ce48ff2c-ea9e-4c12-8629-028d2480b063",
+		"cf5eb3d3-e128-42db-bf1a-161d5dd4b972",
+		"cfe9f5b8-2eeb-42c9-89ff-7e69734adc4d",
+		"d067285f-10ea-4666-99c8-bc23e27e3262",
+		"d1703c3b-8e49-4959-8322-ae11a7ca6632",
+		"d5d57060-ca58-48e1-8903-9b8362c92b0d",
+		"dbdbad44-6a62-4eff-b8f1-95f56588a13a",
+		"dc1b51b3-52e7-4f1c-8770-515d4e1cb53d",
+		"dd9d1cc1-01cb-4bf9-80c4-821e3c449887",
+		"e1e112d7-11e1-4f01-9c91-00a2b1626043",
+		"e2e5e1ef-c613-449a-8400-15581082501b",
+		"ea878730-fde0-4bd0-ad25-95e49f54a1b2",
+		"ebd730e1-1099-41ec-a028-6ef1d4cf91b2",
+		"ec46daa1-49ce-4b88-b2bc-e923672ad0f3",
+		"eccceb7c-834c-4bf9-b0cd-c2dc6fad3dbf",
+		"ecd1ae69-4f63-4e8d-a3f4-9a5c81f98a20",
+		"edd6cffc-8c91-4682-b8af-64cfe823103b",
+		"f04feb7f-971f-4192-893a-46c23180233a",
+		"f16795cc-4378-4e36-b13a-19f9b932228c",
+		"f3ded71d-3cf9-415b-a9d2-b759ca0ce07b",
+		"f682051b-7cc3-4155-aa8b-eb3335b0435c",
+		"f7dc24d2-2a84-46ff-9661-0b8418d68650",
+		"fcd0cb50-b687-4180-90a8-390aeb8705cc",
+	},
+	"dm-02": {
+		"05d946f7-5977-4f51-8bca-ecb39845f1a2",
+		"0bea1262-311a-47b1-888d-dd065cfe3d7f",
+		"0dca6f6c-c426-4c88-b283-043527f04bb3",
+		"17ee5046-c3fd-4422-af14-c54a4be8d9a2",
+		"1eca6a24-9270-477f-a588-80859481ef94",
+		"2c7e38e1-0546-47ab-9388-383d093405b2",
+		"3f0fb8f6-d01e-4005-8340-b84584f50a2a",
+		"48ab3f2b-4ae3-41a4-ae6f-61b49c958bdb",
+		"4b715b5c-2e82-4686-9c9f-4ce1e5503621",
+		"5cf64846-0eb2-4e8d-bf15-4ca573f96e58",
+		"5d095b28-262e-454d-96c7-9174ed83e3f6",
+		"6e381955-231b-4e4e-a14b-82509a5e193b",
+		"9fed2257-362f-43c7-b50e-5526ccf799aa",
+	},
+}
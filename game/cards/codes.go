@@ -0,0 +1,190 @@
+package cards
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"duel-masters/game/format"
+)
+
+// deckCodeVersion is the first byte of every deck code so the encoding can change later on
+const deckCodeVersion = 1
+
+// cardCode is the position of a card in CodeOrder
+type cardCode struct {
+	set   int
+	index int
+}
+
+var (
+	cardCodes     map[string]cardCode
+	cardCodesOnce sync.Once
+)
+
+// cardIndex returns the index of the set the card belongs to and the index of the card within the set
+func cardIndex(uid string) (int, int, bool) {
+
+	cardCodesOnce.Do(func() {
+
+		cardCodes = make(map[string]cardCode)
+
+		for setIndex, setID := range SetOrder {
+			for index, uid := range CodeOrder[setID] {
+				cardCodes[uid] = cardCode{set: setIndex, index: index}
+			}
+		}
+
+	})
+
+	code, ok := cardCodes[uid]
+
+	return code.set, code.index, ok
+
+}
+
+// EncodeDeck returns the deck code of the given cards. The code is the base64url encoding of
+// the version followed by, for each set, its index and number of different cards, and then
+// the index and number of copies of each of those cards, all written as unsigned varints
+func EncodeDeck(cards []string) (string, error) {
+
+	copies := make(map[int]map[int]int)
+
+	for _, uid := range cards {
+
+		setIndex, index, ok := cardIndex(uid)
+
+		if !ok {
+			return "", fmt.Errorf("%s is not a card that can be shared in a deck code", uid)
+		}
+
+		if copies[setIndex] == nil {
+			copies[setIndex] = make(map[int]int)
+		}
+
+		copies[setIndex][index]++
+
+	}
+
+	data := []byte{deckCodeVersion}
+
+	for setIndex := range SetOrder {
+
+		if len(copies[setIndex]) < 1 {
+			continue
+		}
+
+		indices := make([]int, 0)
+		for index := range copies[setIndex] {
+			indices = append(indices, index)
+		}
+
+		sort.Ints(indices)
+
+		data = appendUvarint(data, setIndex)
+		data = appendUvarint(data, len(indices))
+
+		for _, index := range indices {
+			data = appendUvarint(data, index)
+			data = appendUvarint(data, copies[setIndex][index])
+		}
+
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data), nil
+
+}
+
+// DecodeDeck returns the cards of the given deck code
+func DecodeDeck(code string) ([]string, error) {
+
+	data, err := base64.RawURLEncoding.DecodeString(code)
+
+	if err != nil || len(data) < 1 {
+		return nil, errors.New("The deck code is not valid")
+	}
+
+	if data[0] != deckCodeVersion {
+		return nil, fmt.Errorf("Deck codes of version %d are not supported", data[0])
+	}
+
+	r := &uvarintReader{data: data[1:]}
+
+	cards := make([]string, 0)
+
+	for !r.done() {
+
+		setIndex := r.next()
+		count := r.next()
+
+		if r.err != nil || setIndex >= len(SetOrder) {
+			return nil, errors.New("The deck code is not valid")
+		}
+
+		uids := CodeOrder[SetOrder[setIndex]]
+
+		for i := 0; i < count; i++ {
+
+			index := r.next()
+			copies := r.next()
+
+			if r.err != nil || index >= len(uids) {
+				return nil, errors.New("The deck code is not valid")
+			}
+
+			if len(cards)+copies > format.MaxDeckSize() {
+				return nil, fmt.Errorf("A deck code cannot contain more than %d cards", format.MaxDeckSize())
+			}
+
+			for n := 0; n < copies; n++ {
+				cards = append(cards, uids[index])
+			}
+
+		}
+
+	}
+
+	return cards, nil
+
+}
+
+func appendUvarint(data []byte, value int) []byte {
+
+	buf := make([]byte, binary.MaxVarintLen64)
+	n := binary.PutUvarint(buf, uint64(value))
+
+	return append(data, buf[:n]...)
+
+}
+
+// uvarintReader reads unsigned varints until the data ends or an invalid value is read
+type uvarintReader struct {
+	data []byte
+	err  error
+}
+
+func (r *uvarintReader) done() bool {
+	return len(r.data) < 1
+}
+
+func (r *uvarintReader) next() int {
+
+	if r.err != nil {
+		return 0
+	}
+
+	value, n := binary.Uvarint(r.data)
+
+	if n <= 0 || value > 1<<16 {
+		r.err = errors.New("invalid varint")
+		return 0
+	}
+
+	r.data = r.data[n:]
+
+	return int(value)
+
+}
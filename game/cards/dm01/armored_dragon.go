@@ -31,29 +31,14 @@ func BolshackDragon(c *match.Card) {
 	c.Family = family.ArmoredDragon
 	c.ManaCost = 6
 	c.ManaRequirement = []string{civ.Fire}
+	c.Text = "While attacking, this creature gets +1000 power for each fire card in your graveyard."
 
-	c.Use(fx.Creature, fx.Doublebreaker, func(card *match.Card, ctx *match.Context) {
-
-		if event, ok := ctx.Event.(*match.AttackPlayer); ok {
-			if event.CardID != card.ID {
-				return
-			}
-			bolshackSpecial(card)
-		}
-
-		if event, ok := ctx.Event.(*match.AttackCreature); ok {
-			if event.CardID != card.ID {
-				return
-			}
-			bolshackSpecial(card)
-		}
-
-	})
+	c.Use(fx.Creature, fx.Doublebreaker, fx.WhenAttacks(bolshackSpecial))
 
 }
 
 // +1000 power attacker for each fire civilization card in the graveyard
-func bolshackSpecial(card *match.Card) {
+func bolshackSpecial(card *match.Card, ctx *match.Context) {
 
 	card.RemoveConditionBySource(card.ID + "-custom")
 
@@ -45,6 +45,7 @@ func ScarletSkyterror(c *match.Card) {
 	c.Family = family.ArmoredWyvern
 	c.ManaCost = 8
 	c.ManaRequirement = []string{civ.Fire}
+	c.Text = "When you put this creature into the battle zone, destroy all creatures that have \"Blocker\"."
 
 	c.Use(fx.Creature, func(card *match.Card, ctx *match.Context) {
 
@@ -41,6 +41,7 @@ func RothusTheTraveler(c *match.Card) {
 	c.Family = family.Armorloid
 	c.ManaCost = 4
 	c.ManaRequirement = []string{civ.Fire}
+	c.Text = "When you put this creature into the battle zone, each player chooses 1 of their creatures in the battle zone and destroys it."
 
 	c.Use(fx.Creature, func(card *match.Card, ctx *match.Context) {
 
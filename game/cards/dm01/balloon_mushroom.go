@@ -16,6 +16,7 @@ func PoisonousMushroom(c *match.Card) {
 	c.Family = family.BalloonMushroom
 	c.ManaCost = 2
 	c.ManaRequirement = []string{civ.Nature}
+	c.Text = "When you put this creature into the battle zone, you may put 1 card from your hand into your mana zone."
 
 	c.Use(fx.Creature, func(card *match.Card, ctx *match.Context) {
 
@@ -35,7 +36,11 @@ func PoisonousMushroom(c *match.Card) {
 
 				for {
 
-					action := <-card.Player.Action
+					action, ok := <-card.Player.Action
+
+					if !ok {
+						return
+					}
 
 					if action.Cancel {
 						break
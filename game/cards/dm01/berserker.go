@@ -32,6 +32,7 @@ func RaylaTruthEnforcer(c *match.Card) {
 	c.Family = family.Berserker
 	c.ManaCost = 6
 	c.ManaRequirement = []string{civ.Light}
+	c.Text = "When you put this creature into the battle zone, you may search your deck for a spell, show it to your opponent and put it into your hand. Then shuffle your deck."
 
 	c.Use(fx.Creature, func(card *match.Card, ctx *match.Context) {
 
@@ -39,15 +40,13 @@ func RaylaTruthEnforcer(c *match.Card) {
 
 			if event.CardID == card.ID && (event.To == match.BATTLEZONE || event.To == match.SPELLZONE) {
 
-				cards := match.SearchForCnd(card.Player, ctx.Match, card.Player, match.DECK, cnd.Spell, "Select 1 spell from your deck that will be shown to your opponent and sent to your hand", 1, 1, true)
+				cards := match.SearchDeck(card.Player, ctx.Match, "Select 1 spell from your deck that will be shown to your opponent and sent to your hand", 1, 1, true, true, func(x *match.Card) bool { return x.HasCondition(cnd.Spell) })
 
 				for _, c := range cards {
 					card.Player.MoveCard(c.ID, match.DECK, match.HAND)
 					ctx.Match.Chat("Server", fmt.Sprintf("%s was moved from %s's deck to their hand", c.Name, card.Player.Username()))
 				}
 
-				card.Player.ShuffleDeck()
-
 			}
 		}
 
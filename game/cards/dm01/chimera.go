@@ -2,11 +2,9 @@ package dm01
 
 import (
 	"duel-masters/game/civ"
-	"duel-masters/game/cnd"
 	"duel-masters/game/family"
 	"duel-masters/game/fx"
 	"duel-masters/game/match"
-	"fmt"
 )
 
 // Gigaberos ...
@@ -18,6 +16,7 @@ func Gigaberos(c *match.Card) {
 	c.Family = family.Chimera
 	c.ManaCost = 5
 	c.ManaRequirement = []string{civ.Darkness}
+	c.Text = "When you put this creature into the battle zone, destroy 2 of your other creatures or destroy this creature."
 
 	c.Use(fx.Creature, fx.Doublebreaker, func(card *match.Card, ctx *match.Context) {
 
@@ -51,7 +50,11 @@ func Gigaberos(c *match.Card) {
 
 				for {
 
-					action := <-card.Player.Action
+					action, ok := <-card.Player.Action
+
+					if !ok {
+						return
+					}
 
 					if len(action.Cards) < 1 || len(action.Cards) > 2 {
 						ctx.Match.DefaultActionWarning(card.Player)
@@ -125,24 +128,10 @@ func Gigargon(c *match.Card) {
 	c.Family = family.Chimera
 	c.ManaCost = 1
 	c.ManaRequirement = []string{civ.Darkness}
+	c.Text = "When you put this creature into the battle zone, you may return up to 2 creatures from your graveyard to your hand."
 
-	c.Use(fx.Creature, func(card *match.Card, ctx *match.Context) {
-
-		if event, ok := ctx.Event.(*match.CardMoved); ok {
-
-			if event.CardID == card.ID && event.To == match.BATTLEZONE {
-
-				creatures := match.SearchForCnd(card.Player, ctx.Match, card.Player, match.GRAVEYARD, cnd.Creature, "Gigargon: Select up to 2 cards from your graveyard that will be added to your hand", 1, 2, true)
-
-				for _, creature := range creatures {
-					card.Player.MoveCard(creature.ID, match.GRAVEYARD, match.HAND)
-					ctx.Match.Chat("Server", fmt.Sprintf("%s was returned to %s's hand from their graveyard", creature.Name, card.Player.Username()))
-				}
-
-			}
-
-		}
-
-	})
+	c.Use(fx.Creature, fx.WhenSummoned(
+		fx.Apply(fx.MyCards(match.GRAVEYARD).Where(fx.IsCreature).Choose(1, 2).Optional().Prompt("Gigargon: Select up to 2 cards from your graveyard that will be added to your hand"), fx.ReturnCardToHand),
+	))
 
 }
@@ -31,6 +31,7 @@ func StormShell(c *match.Card) {
 	c.Family = family.ColonyBeetle
 	c.ManaCost = 7
 	c.ManaRequirement = []string{civ.Nature}
+	c.Text = "When you put this creature into the battle zone, your opponent chooses 1 of their creatures in the battle zone and puts it into their mana zone."
 
 	c.Use(fx.Creature, func(card *match.Card, ctx *match.Context) {
 
@@ -63,7 +64,11 @@ func StormShell(c *match.Card) {
 
 			for {
 
-				action := <-opponent.Action
+				action, ok := <-opponent.Action
+
+				if !ok {
+					return
+				}
 
 				if len(action.Cards) != 1 || !match.AssertCardsIn(battlezone, action.Cards...) {
 					ctx.Match.ActionWarning(opponent, "Your selection of cards does not fulfill the requirements")
@@ -97,6 +102,7 @@ func TowerShell(c *match.Card) {
 	c.Family = family.ColonyBeetle
 	c.ManaCost = 6
 	c.ManaRequirement = []string{civ.Nature}
+	c.Text = "This creature can't be blocked by creatures that have power less than 4000."
 
 	c.Use(func(card *match.Card, ctx *match.Context) {
 
@@ -16,6 +16,7 @@ func Tropico(c *match.Card) {
 	c.Family = family.CyberLord
 	c.ManaCost = 5
 	c.ManaRequirement = []string{civ.Water}
+	c.Text = "While you have at least 2 other creatures in the battle zone, this creature can't be blocked."
 
 	c.Use(func(card *match.Card, ctx *match.Context) {
 
@@ -16,6 +16,7 @@ func VampireSilphy(c *match.Card) {
 	c.Family = family.DarkLord
 	c.ManaCost = 8
 	c.ManaRequirement = []string{civ.Darkness}
+	c.Text = "When you put this creature into the battle zone, destroy all creatures that have power 3000 or less."
 
 	c.Use(fx.Creature, func(card *match.Card, ctx *match.Context) {
 
@@ -31,6 +31,7 @@ func ExplosiveFighterUcarn(c *match.Card) {
 	c.Family = family.Dragonoid
 	c.ManaCost = 5
 	c.ManaRequirement = []string{civ.Fire}
+	c.Text = "When you put this creature into the battle zone, put 2 cards from your mana zone into your graveyard."
 
 	c.Use(fx.Creature, fx.Doublebreaker, func(card *match.Card, ctx *match.Context) {
 		if event, ok := ctx.Event.(*match.CardMoved); ok {
@@ -74,6 +75,7 @@ func OnslaughterTriceps(c *match.Card) {
 	c.Family = family.Dragonoid
 	c.ManaCost = 3
 	c.ManaRequirement = []string{civ.Fire}
+	c.Text = "When you put this creature into the battle zone, put 1 card from your mana zone into your graveyard."
 
 	c.Use(fx.Creature, func(card *match.Card, ctx *match.Context) {
 		if event, ok := ctx.Event.(*match.CardMoved); ok {
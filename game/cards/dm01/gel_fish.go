@@ -17,6 +17,7 @@ func IllusionaryMerfolk(c *match.Card) {
 	c.Family = family.GelFish
 	c.ManaCost = 5
 	c.ManaRequirement = []string{civ.Water}
+	c.Text = "When you put this creature into the battle zone, if you have a Cyber Lord in the battle zone, draw 3 cards."
 
 	c.Use(fx.Creature, func(card *match.Card, ctx *match.Context) {
 
@@ -84,6 +85,7 @@ func SaucerHeadShark(c *match.Card) {
 	c.Family = family.GelFish
 	c.ManaCost = 5
 	c.ManaRequirement = []string{civ.Water}
+	c.Text = "When you put this creature into the battle zone, return each creature in the battle zone that has power 2000 or less to its owner's hand."
 
 	c.Use(fx.Creature, func(card *match.Card, ctx *match.Context) {
 
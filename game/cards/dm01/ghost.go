@@ -5,8 +5,6 @@ import (
 	"duel-masters/game/family"
 	"duel-masters/game/fx"
 	"duel-masters/game/match"
-	"fmt"
-	"math/rand"
 )
 
 // DarkRavenShadowOfGrief ...
@@ -32,33 +30,11 @@ func MaskedHorrorShadowOfScorn(c *match.Card) {
 	c.Family = family.Ghost
 	c.ManaCost = 5
 	c.ManaRequirement = []string{civ.Darkness}
+	c.Text = "When you put this creature into the battle zone, your opponent discards a card at random from their hand."
 
-	c.Use(fx.Creature, func(card *match.Card, ctx *match.Context) {
-
-		if event, ok := ctx.Event.(*match.CardMoved); ok {
-
-			if event.CardID == card.ID && event.To == match.BATTLEZONE {
-
-				hand, err := ctx.Match.Opponent(card.Player).Container(match.HAND)
-
-				if err != nil {
-					return
-				}
-
-				if len(hand) < 1 {
-					return
-				}
-
-				discardedCard, err := ctx.Match.Opponent(card.Player).MoveCard(hand[rand.Intn(len(hand))].ID, match.HAND, match.GRAVEYARD)
-				if err == nil {
-					ctx.Match.Chat("Server", fmt.Sprintf("%s was discarded from %s's hand", discardedCard.Name, discardedCard.Player.Username()))
-				}
-
-			}
-
-		}
-
-	})
+	c.Use(fx.Creature, fx.WhenSummoned(
+		fx.Apply(fx.OpponentsCards(match.HAND).Random(1), fx.DiscardCard),
+	))
 
 }
 
@@ -30,6 +30,7 @@ func StampedingLonghorn(c *match.Card) {
 	c.Family = family.HornedBeast
 	c.ManaCost = 5
 	c.ManaRequirement = []string{civ.Nature}
+	c.Text = "This creature can't be blocked by creatures that have power less than 3000."
 
 	c.Use(func(card *match.Card, ctx *match.Context) {
 
@@ -59,24 +59,11 @@ func MieleVizierOfLightning(c *match.Card) {
 	c.Family = family.Initiate
 	c.ManaCost = 3
 	c.ManaRequirement = []string{civ.Light}
+	c.Text = "When you put this creature into the battle zone, you may choose 1 of your opponent's creatures in the battle zone and tap it."
 
-	c.Use(fx.Creature, func(card *match.Card, ctx *match.Context) {
-
-		if event, ok := ctx.Event.(*match.CardMoved); ok {
-
-			if event.CardID == card.ID && event.To == match.BATTLEZONE {
-
-				creatures := match.Search(card.Player, ctx.Match, ctx.Match.Opponent(card.Player), match.BATTLEZONE, "Miele, Vizier of Lightning: Select 1 of your opponent's creature and tap it. Close to not tap any creatures.", 1, 1, true)
-
-				for _, creature := range creatures {
-					creature.Tapped = true
-				}
-
-			}
-
-		}
-
-	})
+	c.Use(fx.Creature, fx.WhenSummoned(
+		fx.Apply(fx.OpponentsCreatures().Optional().Prompt("Miele, Vizier of Lightning: Select 1 of your opponent's creature and tap it. Close to not tap any creatures."), fx.TapCard),
+	))
 
 }
 
@@ -89,6 +76,7 @@ func ToelVizierOfLight(c *match.Card) {
 	c.Family = family.Initiate
 	c.ManaCost = 5
 	c.ManaRequirement = []string{civ.Light}
+	c.Text = "At the end of your turn, untap all your creatures in the battle zone."
 
 	c.Use(fx.Creature, func(card *match.Card, ctx *match.Context) {
 
@@ -16,24 +16,11 @@ func StingerWorm(c *match.Card) {
 	c.Family = family.ParasiteWorm
 	c.ManaCost = 3
 	c.ManaRequirement = []string{civ.Darkness}
+	c.Text = "When you put this creature into the battle zone, destroy 1 of your creatures."
 
-	c.Use(fx.Creature, func(card *match.Card, ctx *match.Context) {
-
-		if event, ok := ctx.Event.(*match.CardMoved); ok {
-
-			if event.CardID == card.ID && event.To == match.BATTLEZONE {
-
-				creatures := match.Search(card.Player, ctx.Match, card.Player, match.BATTLEZONE, "Stinger Worm: Select 1 creature from your battlezone that will be sent to your graveyard", 1, 1, false)
-
-				for _, creature := range creatures {
-					ctx.Match.Destroy(creature, card)
-				}
-
-			}
-
-		}
-
-	})
+	c.Use(fx.Creature, fx.WhenSummoned(
+		fx.Apply(fx.MyCreatures().Prompt("Stinger Worm: Select 1 creature from your battlezone that will be sent to your graveyard"), fx.DestroyCard),
+	))
 
 }
 
@@ -46,6 +33,7 @@ func SwampWorm(c *match.Card) {
 	c.Family = family.ParasiteWorm
 	c.ManaCost = 1
 	c.ManaRequirement = []string{civ.Darkness}
+	c.Text = "When you put this creature into the battle zone, your opponent chooses 1 of their creatures and destroys it."
 
 	c.Use(fx.Creature, func(card *match.Card, ctx *match.Context) {
 
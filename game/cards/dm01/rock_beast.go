@@ -17,6 +17,7 @@ func Bombersaur(c *match.Card) {
 	c.Family = family.RockBeast
 	c.ManaCost = 5
 	c.ManaRequirement = []string{civ.Fire}
+	c.Text = "When this creature is destroyed, each player chooses 2 cards in their mana zone and puts them into their graveyard."
 
 	c.Use(fx.Creature, func(card *match.Card, ctx *match.Context) {
 
@@ -51,7 +52,11 @@ func Bombersaur(c *match.Card) {
 
 					for {
 
-						action := <-p.Action
+						action, ok := <-p.Action
+
+						if !ok {
+							return
+						}
 
 						if len(action.Cards) != toSelect || !match.AssertCardsIn(manazone, action.Cards...) {
 							ctx.Match.DefaultActionWarning(p)
@@ -92,33 +97,11 @@ func Meteosaur(c *match.Card) {
 	c.Family = family.RockBeast
 	c.ManaCost = 5
 	c.ManaRequirement = []string{civ.Fire}
+	c.Text = "When you put this creature into the battle zone, you may destroy 1 of your opponent's creatures that has power 2000 or less."
 
-	c.Use(fx.Creature, func(card *match.Card, ctx *match.Context) {
-
-		if event, ok := ctx.Event.(*match.CardMoved); ok {
-
-			if event.CardID == card.ID && event.To == match.BATTLEZONE {
-
-				creatures := match.Filter(
-					card.Player,
-					ctx.Match,
-					ctx.Match.Opponent(card.Player),
-					match.BATTLEZONE,
-					"Meteosaur: Select 1 of your opponent's creatures with power 2000 or less and destroy it",
-					1,
-					1,
-					true,
-					func(x *match.Card) bool { return x.Power <= 2000 },
-				)
-
-				for _, creature := range creatures {
-					ctx.Match.Destroy(creature, card)
-				}
-
-			}
-		}
-
-	})
+	c.Use(fx.Creature, fx.WhenSummoned(
+		fx.Apply(fx.OpponentsCreatures().Where(fx.PowerAtMost(2000)).Optional().Prompt("Meteosaur: Select 1 of your opponent's creatures with power 2000 or less and destroy it"), fx.DestroyCard),
+	))
 
 }
 
@@ -6,7 +6,6 @@ import (
 	"duel-masters/game/fx"
 	"duel-masters/game/match"
 	"fmt"
-	"math/rand"
 )
 
 // AuraBlast ...
@@ -16,19 +15,11 @@ func AuraBlast(c *match.Card) {
 	c.Civ = civ.Nature
 	c.ManaCost = 4
 	c.ManaRequirement = []string{civ.Nature}
+	c.Text = "Each of your creatures in the battle zone gets \"Power attacker +2000\" until the end of the turn."
 
-	c.Use(fx.Spell, func(card *match.Card, ctx *match.Context) {
-
-		if match.AmICasted(card, ctx) {
-
-			card.Player.MapContainer(match.BATTLEZONE, func(creature *match.Card) {
-				creature.AddCondition(cnd.PowerAttacker, 2000, card.ID)
-				ctx.Match.Chat("Server", fmt.Sprintf("%s was given power attacker +2000", creature.Name))
-			})
-
-		}
-
-	})
+	c.Use(fx.Spell, fx.WhenCast(
+		fx.Apply(fx.MyCreatures().All(), fx.AddPower(2000)),
+	))
 
 }
 
@@ -39,16 +30,9 @@ func BrainSerum(c *match.Card) {
 	c.Civ = civ.Water
 	c.ManaCost = 4
 	c.ManaRequirement = []string{civ.Water}
+	c.Text = "Draw 2 cards."
 
-	c.Use(fx.Spell, fx.ShieldTrigger, func(card *match.Card, ctx *match.Context) {
-
-		if match.AmICasted(card, ctx) {
-
-			card.Player.DrawCards(2)
-
-		}
-
-	})
+	c.Use(fx.Spell, fx.ShieldTrigger, fx.WhenCast(fx.DrawCards(2)))
 
 }
 
@@ -59,23 +43,11 @@ func BurningPower(c *match.Card) {
 	c.Civ = civ.Fire
 	c.ManaCost = 1
 	c.ManaRequirement = []string{civ.Fire}
+	c.Text = "One of your creatures gets \"Power attacker +2000\" until the end of the turn."
 
-	c.Use(fx.Spell, func(card *match.Card, ctx *match.Context) {
-
-		if match.AmICasted(card, ctx) {
-
-			creatures := match.Search(card.Player, ctx.Match, card.Player, match.BATTLEZONE, "Select 1 creature from your battlezone that will gain \"Power Attacker +2000\"", 1, 1, false)
-
-			for _, creature := range creatures {
-
-				creature.AddCondition(cnd.PowerAttacker, 2000, card.ID)
-				ctx.Match.Chat("Server", fmt.Sprintf("%s was given power attacker +2000", creature.Name))
-
-			}
-
-		}
-
-	})
+	c.Use(fx.Spell, fx.WhenCast(
+		fx.Apply(fx.MyCreatures().Prompt("Select 1 creature from your battlezone that will gain \"Power Attacker +2000\""), fx.AddPower(2000)),
+	))
 
 }
 
@@ -86,23 +58,11 @@ func ChaosStrike(c *match.Card) {
 	c.Civ = civ.Fire
 	c.ManaCost = 2
 	c.ManaRequirement = []string{civ.Fire}
+	c.Text = "Choose 1 of your opponent's creatures in the battle zone and tap it."
 
-	c.Use(fx.Spell, func(card *match.Card, ctx *match.Context) {
-
-		if match.AmICasted(card, ctx) {
-
-			creatures := match.Search(card.Player, ctx.Match, ctx.Match.Opponent(card.Player), match.BATTLEZONE, "Select 1 of your opponent's creatures that will be tapped", 1, 1, false)
-
-			for _, creature := range creatures {
-
-				creature.Tapped = true
-				ctx.Match.Chat("Server", fmt.Sprintf("%s was tapped by %s", creature.Name, card.Name))
-
-			}
-
-		}
-
-	})
+	c.Use(fx.Spell, fx.WhenCast(
+		fx.Apply(fx.OpponentsCreatures().Prompt("Select 1 of your opponent's creatures that will be tapped"), fx.TapCard),
+	))
 
 }
 
@@ -113,6 +73,7 @@ func CreepingPlague(c *match.Card) {
 	c.Civ = civ.Darkness
 	c.ManaCost = 1
 	c.ManaRequirement = []string{civ.Darkness}
+	c.Text = "Whenever any of your creatures becomes blocked this turn, it gets \"Slayer\" until the end of the turn."
 
 	c.Use(fx.Spell, func(card *match.Card, ctx *match.Context) {
 
@@ -142,22 +103,11 @@ func CrimsonHammer(c *match.Card) {
 	c.Civ = civ.Fire
 	c.ManaCost = 2
 	c.ManaRequirement = []string{civ.Fire}
+	c.Text = "Destroy 1 of your opponent's creatures that has power 2000 or less."
 
-	c.Use(fx.Spell, func(card *match.Card, ctx *match.Context) {
-
-		if match.AmICasted(card, ctx) {
-
-			creatures := match.Filter(card.Player, ctx.Match, ctx.Match.Opponent(card.Player), match.BATTLEZONE, "Select 1 of your opponent's creatures that will be tapped", 1, 1, false, func(x *match.Card) bool { return x.Power <= 2000 })
-
-			for _, creature := range creatures {
-
-				ctx.Match.Destroy(creature, card)
-
-			}
-
-		}
-
-	})
+	c.Use(fx.Spell, fx.WhenCast(
+		fx.Apply(fx.OpponentsCreatures().Where(fx.PowerAtMost(2000)).Prompt("Destroy one of your opponent's creatures that has power 2000 or less"), fx.DestroyCard),
+	))
 
 }
 
@@ -168,12 +118,13 @@ func CrystalMemory(c *match.Card) {
 	c.Civ = civ.Water
 	c.ManaCost = 4
 	c.ManaRequirement = []string{civ.Water}
+	c.Text = "Search your deck. You may take a card from your deck and put it into your hand. Then shuffle your deck."
 
 	c.Use(fx.Spell, fx.ShieldTrigger, func(card *match.Card, ctx *match.Context) {
 
 		if match.AmICasted(card, ctx) {
 
-			selectedCards := match.Search(card.Player, ctx.Match, card.Player, match.DECK, "Select 1 card from your deck that will be sent to your hand", 1, 1, false)
+			selectedCards := match.SearchDeck(card.Player, ctx.Match, "Select 1 card from your deck that will be sent to your hand", 1, 1, false, false, nil)
 
 			for _, selectedCard := range selectedCards {
 
@@ -181,8 +132,6 @@ func CrystalMemory(c *match.Card) {
 
 			}
 
-			card.Player.ShuffleDeck()
-
 			ctx.Match.Chat("Server", card.Player.Username()+" retrieved a card from their deck")
 
 		}
@@ -198,23 +147,11 @@ func DarkReversal(c *match.Card) {
 	c.Civ = civ.Darkness
 	c.ManaCost = 2
 	c.ManaRequirement = []string{civ.Darkness}
+	c.Text = "Return a creature from your graveyard to your hand."
 
-	c.Use(fx.Spell, fx.ShieldTrigger, func(card *match.Card, ctx *match.Context) {
-
-		if match.AmICasted(card, ctx) {
-
-			creatures := match.Filter(card.Player, ctx.Match, card.Player, match.GRAVEYARD, "Select 1 creature from your graveyard that will be sent to your hand", 1, 1, false, func(x *match.Card) bool { return x.HasCondition(cnd.Creature) })
-
-			for _, creature := range creatures {
-
-				card.Player.MoveCard(creature.ID, match.GRAVEYARD, match.HAND)
-				ctx.Match.Chat("Server", fmt.Sprintf("%s retrieved %s from their graveyard", card.Player.Username(), creature.Name))
-
-			}
-
-		}
-
-	})
+	c.Use(fx.Spell, fx.ShieldTrigger, fx.WhenCast(
+		fx.Apply(fx.MyCards(match.GRAVEYARD).Where(fx.IsCreature).Prompt("Select 1 creature from your graveyard that will be sent to your hand"), fx.ReturnCardToHand),
+	))
 
 }
 
@@ -225,22 +162,11 @@ func DeathSmoke(c *match.Card) {
 	c.Civ = civ.Darkness
 	c.ManaCost = 4
 	c.ManaRequirement = []string{civ.Darkness}
+	c.Text = "Destroy 1 of your opponent's untapped creatures."
 
-	c.Use(fx.Spell, func(card *match.Card, ctx *match.Context) {
-
-		if match.AmICasted(card, ctx) {
-
-			creatures := match.Filter(card.Player, ctx.Match, ctx.Match.Opponent(card.Player), match.BATTLEZONE, "Destroy one of your opponent's untapped creatures", 1, 1, false, func(x *match.Card) bool { return x.Tapped == false })
-
-			for _, creature := range creatures {
-
-				ctx.Match.Destroy(creature, card)
-
-			}
-
-		}
-
-	})
+	c.Use(fx.Spell, fx.WhenCast(
+		fx.Apply(fx.OpponentsCreatures().Where(fx.IsUntapped).Prompt("Destroy one of your opponent's untapped creatures"), fx.DestroyCard),
+	))
 
 }
 
@@ -251,25 +177,12 @@ func DimensionGate(c *match.Card) {
 	c.Civ = civ.Nature
 	c.ManaCost = 3
 	c.ManaRequirement = []string{civ.Nature}
+	c.Text = "Search your deck. You may take a creature from your deck, show that creature to your opponent, and put it into your hand. Then shuffle your deck."
 
-	c.Use(fx.Spell, fx.ShieldTrigger, func(card *match.Card, ctx *match.Context) {
-
-		if match.AmICasted(card, ctx) {
-
-			creatures := match.Filter(card.Player, ctx.Match, card.Player, match.DECK, "Select 1 creature from your deck that will be shown to your opponent and sent to your hand", 1, 1, false, func(x *match.Card) bool { return x.HasCondition(cnd.Creature) })
-
-			for _, creature := range creatures {
-
-				card.Player.MoveCard(creature.ID, match.DECK, match.HAND)
-				ctx.Match.Chat("Server", fmt.Sprintf("%s retrieved %s from the deck to their hand", card.Player.Username(), creature.Name))
-
-			}
-
-			card.Player.ShuffleDeck()
-
-		}
-
-	})
+	c.Use(fx.Spell, fx.ShieldTrigger, fx.WhenCast(
+		fx.Apply(fx.MyCards(match.DECK).Where(fx.IsCreature).Prompt("Select 1 creature from your deck that will be shown to your opponent and sent to your hand"), fx.ReturnCardToHand),
+		fx.ShuffleDeck,
+	))
 
 }
 
@@ -280,29 +193,11 @@ func GhostTouch(c *match.Card) {
 	c.Civ = civ.Darkness
 	c.ManaCost = 2
 	c.ManaRequirement = []string{civ.Darkness}
+	c.Text = "Your opponent discards a card at random from their hand."
 
-	c.Use(fx.Spell, fx.ShieldTrigger, func(card *match.Card, ctx *match.Context) {
-
-		if match.AmICasted(card, ctx) {
-
-			hand, err := ctx.Match.Opponent(card.Player).Container(match.HAND)
-
-			if err != nil {
-				return
-			}
-
-			if len(hand) < 1 {
-				return
-			}
-
-			discardedCard, err := ctx.Match.Opponent(card.Player).MoveCard(hand[rand.Intn(len(hand))].ID, match.HAND, match.GRAVEYARD)
-			if err == nil {
-				ctx.Match.Chat("Server", fmt.Sprintf("%s was discarded from %s's hand", discardedCard.Name, discardedCard.Player.Username()))
-			}
-
-		}
-
-	})
+	c.Use(fx.Spell, fx.ShieldTrigger, fx.WhenCast(
+		fx.Apply(fx.OpponentsCards(match.HAND).Random(1), fx.DiscardCard),
+	))
 
 }
 
@@ -313,25 +208,11 @@ func HolyAwe(c *match.Card) {
 	c.Civ = civ.Light
 	c.ManaCost = 6
 	c.ManaRequirement = []string{civ.Light}
+	c.Text = "Tap all your opponent's creatures in the battle zone."
 
-	c.Use(fx.Spell, fx.ShieldTrigger, func(card *match.Card, ctx *match.Context) {
-
-		if match.AmICasted(card, ctx) {
-
-			creatures, err := ctx.Match.Opponent(card.Player).Container(match.BATTLEZONE)
-
-			if err != nil {
-				return
-			}
-
-			for _, creature := range creatures {
-				creature.Tapped = true
-				ctx.Match.Chat("Server", fmt.Sprintf("%s was tapped by Holy Awe", creature.Name))
-			}
-
-		}
-
-	})
+	c.Use(fx.Spell, fx.ShieldTrigger, fx.WhenCast(
+		fx.Apply(fx.OpponentsCreatures().All(), fx.TapCard),
+	))
 
 }
 
@@ -342,23 +223,11 @@ func LaserWing(c *match.Card) {
 	c.Civ = civ.Light
 	c.ManaCost = 5
 	c.ManaRequirement = []string{civ.Light}
+	c.Text = "Choose up to 2 of your creatures in the battle zone. They can't be blocked this turn."
 
-	c.Use(fx.Spell, func(card *match.Card, ctx *match.Context) {
-
-		if match.AmICasted(card, ctx) {
-
-			creatures := match.Search(card.Player, ctx.Match, card.Player, match.BATTLEZONE, "Select up to 2 creatures that can't be blocked this turn", 1, 2, false)
-
-			for _, creature := range creatures {
-
-				creature.AddCondition(cnd.CantBeBlocked, nil, card.ID)
-				ctx.Match.Chat("Server", creature.Name+" can't be blocked this turn")
-
-			}
-
-		}
-
-	})
+	c.Use(fx.Spell, fx.WhenCast(
+		fx.Apply(fx.MyCreatures().Choose(1, 2).Prompt("Select up to 2 creatures that can't be blocked this turn"), fx.Give(cnd.CantBeBlocked)),
+	))
 
 }
 
@@ -369,24 +238,11 @@ func MagmaGazer(c *match.Card) {
 	c.Civ = civ.Fire
 	c.ManaCost = 3
 	c.ManaRequirement = []string{civ.Fire}
+	c.Text = "One of your creatures gets \"Power attacker +4000\" and \"Double breaker\" until the end of the turn."
 
-	c.Use(fx.Spell, func(card *match.Card, ctx *match.Context) {
-
-		if match.AmICasted(card, ctx) {
-
-			creatures := match.Search(card.Player, ctx.Match, card.Player, match.BATTLEZONE, "Select 1 creature from your battlezone that will gain \"Power Attacker +4000\" and \"Double breaker\"", 1, 1, false)
-
-			for _, creature := range creatures {
-
-				creature.AddCondition(cnd.PowerAttacker, 4000, card.ID)
-				creature.AddCondition(cnd.DoubleBreaker, nil, card.ID)
-				ctx.Match.Chat("Server", fmt.Sprintf("%s was given power attacker +4000 and double breaker until the end of the turn", creature.Name))
-
-			}
-
-		}
-
-	})
+	c.Use(fx.Spell, fx.WhenCast(
+		fx.Apply(fx.MyCreatures().Prompt("Select 1 creature from your battlezone that will gain \"Power Attacker +4000\" and \"Double breaker\""), fx.AddPower(4000), fx.Give(cnd.DoubleBreaker)),
+	))
 
 }
 
@@ -397,23 +253,11 @@ func MoonlightFlash(c *match.Card) {
 	c.Civ = civ.Light
 	c.ManaCost = 4
 	c.ManaRequirement = []string{civ.Light}
+	c.Text = "Choose up to 2 of your opponent's creatures in the battle zone and tap them."
 
-	c.Use(fx.Spell, func(card *match.Card, ctx *match.Context) {
-
-		if match.AmICasted(card, ctx) {
-
-			creatures := match.Search(card.Player, ctx.Match, ctx.Match.Opponent(card.Player), match.BATTLEZONE, "Select up to 2 of your opponents creatures that will be tapped", 1, 2, false)
-
-			for _, creature := range creatures {
-
-				creature.Tapped = true
-				ctx.Match.Chat("Server", creature.Name+" was tapped")
-
-			}
-
-		}
-
-	})
+	c.Use(fx.Spell, fx.WhenCast(
+		fx.Apply(fx.OpponentsCreatures().Choose(1, 2).Prompt("Select up to 2 of your opponents creatures that will be tapped"), fx.TapCard),
+	))
 
 }
 
@@ -424,24 +268,11 @@ func NaturalSnare(c *match.Card) {
 	c.Civ = civ.Nature
 	c.ManaCost = 6
 	c.ManaRequirement = []string{civ.Nature}
+	c.Text = "Choose 1 of your opponent's creatures in the battle zone and put it into their mana zone."
 
-	c.Use(fx.Spell, fx.ShieldTrigger, func(card *match.Card, ctx *match.Context) {
-
-		if match.AmICasted(card, ctx) {
-
-			creatures := match.Search(card.Player, ctx.Match, ctx.Match.Opponent(card.Player), match.BATTLEZONE, "Select 1 of your opponent's creatures and put it in their manazone", 1, 1, false)
-
-			for _, creature := range creatures {
-
-				creature.Player.MoveCard(creature.ID, match.BATTLEZONE, match.MANAZONE)
-				creature.Tapped = false
-				ctx.Match.Chat("Server", fmt.Sprintf("%s was moved to %s's manazone", creature.Name, creature.Player.Username()))
-
-			}
-
-		}
-
-	})
+	c.Use(fx.Spell, fx.ShieldTrigger, fx.WhenCast(
+		fx.Apply(fx.OpponentsCreatures().Prompt("Select 1 of your opponent's creatures and put it in their manazone"), fx.PutCardIntoMana),
+	))
 
 }
 
@@ -452,24 +283,11 @@ func PangeasSong(c *match.Card) {
 	c.Civ = civ.Nature
 	c.ManaCost = 1
 	c.ManaRequirement = []string{civ.Nature}
+	c.Text = "Put 1 of your creatures from the battle zone into your mana zone."
 
-	c.Use(fx.Spell, func(card *match.Card, ctx *match.Context) {
-
-		if match.AmICasted(card, ctx) {
-
-			creatures := match.Search(card.Player, ctx.Match, card.Player, match.BATTLEZONE, "Select 1 of your creatures and put it in your manazone", 1, 1, false)
-
-			for _, creature := range creatures {
-
-				creature.Player.MoveCard(creature.ID, match.BATTLEZONE, match.MANAZONE)
-				creature.Tapped = false
-				ctx.Match.Chat("Server", fmt.Sprintf("%s was moved to %s's manazone", creature.Name, creature.Player.Username()))
-
-			}
-
-		}
-
-	})
+	c.Use(fx.Spell, fx.WhenCast(
+		fx.Apply(fx.MyCreatures().Prompt("Select 1 of your creatures and put it in your manazone"), fx.PutCardIntoMana),
+	))
 
 }
 
@@ -480,23 +298,11 @@ func SolarRay(c *match.Card) {
 	c.Civ = civ.Light
 	c.ManaCost = 2
 	c.ManaRequirement = []string{civ.Light}
+	c.Text = "Choose 1 of your opponent's creatures in the battle zone and tap it."
 
-	c.Use(fx.Spell, fx.ShieldTrigger, func(card *match.Card, ctx *match.Context) {
-
-		if match.AmICasted(card, ctx) {
-
-			creatures := match.Search(card.Player, ctx.Match, ctx.Match.Opponent(card.Player), match.BATTLEZONE, "Select 1 of your opponents creatures that will be tapped", 1, 1, false)
-
-			for _, creature := range creatures {
-
-				creature.Tapped = true
-				ctx.Match.Chat("Server", creature.Name+" was tapped")
-
-			}
-
-		}
-
-	})
+	c.Use(fx.Spell, fx.ShieldTrigger, fx.WhenCast(
+		fx.Apply(fx.OpponentsCreatures().Prompt("Select 1 of your opponents creatures that will be tapped"), fx.TapCard),
+	))
 
 }
 
@@ -507,23 +313,11 @@ func SonicWing(c *match.Card) {
 	c.Civ = civ.Light
 	c.ManaCost = 3
 	c.ManaRequirement = []string{civ.Light}
+	c.Text = "One of your creatures can't be blocked this turn."
 
-	c.Use(fx.Spell, func(card *match.Card, ctx *match.Context) {
-
-		if match.AmICasted(card, ctx) {
-
-			creatures := match.Search(card.Player, ctx.Match, card.Player, match.BATTLEZONE, "Select 1 creature that can't be blocked this turn", 1, 1, false)
-
-			for _, creature := range creatures {
-
-				creature.AddCondition(cnd.CantBeBlocked, nil, card.ID)
-				ctx.Match.Chat("Server", creature.Name+" can't be blocked this turn")
-
-			}
-
-		}
-
-	})
+	c.Use(fx.Spell, fx.WhenCast(
+		fx.Apply(fx.MyCreatures().Prompt("Select 1 creature that can't be blocked this turn"), fx.Give(cnd.CantBeBlocked)),
+	))
 
 }
 
@@ -534,70 +328,11 @@ func SpiralGate(c *match.Card) {
 	c.Civ = civ.Water
 	c.ManaCost = 2
 	c.ManaRequirement = []string{civ.Water}
+	c.Text = "Choose 1 creature in the battle zone and return it to its owner's hand."
 
-	c.Use(fx.Spell, fx.ShieldTrigger, func(card *match.Card, ctx *match.Context) {
-
-		if match.AmICasted(card, ctx) {
-
-			cards := make(map[string][]*match.Card)
-
-			myCards, err := card.Player.Container(match.BATTLEZONE)
-
-			if err != nil {
-				return
-			}
-
-			opponentCards, err := ctx.Match.Opponent(card.Player).Container(match.BATTLEZONE)
-
-			if err != nil {
-				return
-			}
-
-			if len(myCards) < 1 && len(opponentCards) < 1 {
-				return
-			}
-
-			cards["Your creatures"] = myCards
-			cards["Opponent's creatures"] = opponentCards
-
-			ctx.Match.NewMultipartAction(card.Player, cards, 1, 1, "Spiral Gate: Select 1 creature in the battlezone and return it to its owner's hand", false)
-
-			for {
-
-				action := <-card.Player.Action
-
-				if len(action.Cards) != 1 {
-					ctx.Match.DefaultActionWarning(card.Player)
-					continue
-				}
-
-				for _, vid := range action.Cards {
-
-					ref, err := c.Player.MoveCard(vid, match.BATTLEZONE, match.HAND)
-
-					if err != nil {
-
-						ref, err := ctx.Match.Opponent(c.Player).MoveCard(vid, match.BATTLEZONE, match.HAND)
-
-						if err == nil {
-							ctx.Match.Chat("Server", fmt.Sprintf("%s was moved to %s's hand", ref.Name, ctx.Match.PlayerRef(ref.Player).Socket.User.Username))
-						}
-
-					} else {
-						ctx.Match.Chat("Server", fmt.Sprintf("%s was moved to %s's hand", ref.Name, ctx.Match.PlayerRef(ref.Player).Socket.User.Username))
-					}
-
-				}
-
-				break
-
-			}
-
-			ctx.Match.CloseAction(c.Player)
-
-		}
-
-	})
+	c.Use(fx.Spell, fx.ShieldTrigger, fx.WhenCast(
+		fx.Apply(fx.AnyCreatures().Prompt("Spiral Gate: Select 1 creature in the battlezone and return it to its owner's hand"), fx.ReturnCardToHand),
+	))
 
 }
 
@@ -608,70 +343,11 @@ func Teleportation(c *match.Card) {
 	c.Civ = civ.Water
 	c.ManaCost = 5
 	c.ManaRequirement = []string{civ.Water}
+	c.Text = "Choose up to 2 creatures in the battle zone and return them to their owners' hands."
 
-	c.Use(fx.Spell, func(card *match.Card, ctx *match.Context) {
-
-		if match.AmICasted(card, ctx) {
-
-			cards := make(map[string][]*match.Card)
-
-			myCards, err := card.Player.Container(match.BATTLEZONE)
-
-			if err != nil {
-				return
-			}
-
-			opponentCards, err := ctx.Match.Opponent(card.Player).Container(match.BATTLEZONE)
-
-			if err != nil {
-				return
-			}
-
-			if len(myCards) < 1 && len(opponentCards) < 1 {
-				return
-			}
-
-			cards["Your creatures"] = myCards
-			cards["Opponent's creatures"] = opponentCards
-
-			ctx.Match.NewMultipartAction(card.Player, cards, 1, 2, "Teleportation: Select up to 2 creatures in the battlezone and return it to its owner's hand", false)
-
-			for {
-
-				action := <-card.Player.Action
-
-				if len(action.Cards) < 1 || len(action.Cards) > 2 {
-					ctx.Match.DefaultActionWarning(card.Player)
-					continue
-				}
-
-				for _, vid := range action.Cards {
-
-					ref, err := c.Player.MoveCard(vid, match.BATTLEZONE, match.HAND)
-
-					if err != nil {
-
-						ref, err := ctx.Match.Opponent(c.Player).MoveCard(vid, match.BATTLEZONE, match.HAND)
-
-						if err == nil {
-							ctx.Match.Chat("Server", fmt.Sprintf("%s was moved to %s's hand", ref.Name, ctx.Match.PlayerRef(ref.Player).Socket.User.Username))
-						}
-
-					} else {
-						ctx.Match.Chat("Server", fmt.Sprintf("%s was moved to %s's hand", ref.Name, ctx.Match.PlayerRef(ref.Player).Socket.User.Username))
-					}
-
-				}
-
-				break
-
-			}
-
-			ctx.Match.CloseAction(c.Player)
-
-		}
-
-	})
+	c.Use(fx.Spell, fx.WhenCast(
+		fx.Apply(fx.AnyCreatures().Choose(1, 2).Prompt("Teleportation: Select up to 2 creatures in the battlezone and return them to their owners' hands"), fx.ReturnCardToHand),
+	))
 
 }
 
@@ -682,22 +358,11 @@ func TerrorPit(c *match.Card) {
 	c.Civ = civ.Darkness
 	c.ManaCost = 6
 	c.ManaRequirement = []string{civ.Darkness}
+	c.Text = "Destroy 1 of your opponent's creatures."
 
-	c.Use(fx.Spell, fx.ShieldTrigger, func(card *match.Card, ctx *match.Context) {
-
-		if match.AmICasted(card, ctx) {
-
-			creatures := match.Search(card.Player, ctx.Match, ctx.Match.Opponent(card.Player), match.BATTLEZONE, "Destroy one of your opponent's creatures", 1, 1, false)
-
-			for _, creature := range creatures {
-
-				ctx.Match.Destroy(creature, card)
-
-			}
-
-		}
-
-	})
+	c.Use(fx.Spell, fx.ShieldTrigger, fx.WhenCast(
+		fx.Apply(fx.OpponentsCreatures().Prompt("Destroy one of your opponent's creatures"), fx.DestroyCard),
+	))
 
 }
 
@@ -708,22 +373,11 @@ func TornadoFlame(c *match.Card) {
 	c.Civ = civ.Fire
 	c.ManaCost = 5
 	c.ManaRequirement = []string{civ.Fire}
+	c.Text = "Destroy 1 of your opponent's creatures that has power 4000 or less."
 
-	c.Use(fx.Spell, fx.ShieldTrigger, func(card *match.Card, ctx *match.Context) {
-
-		if match.AmICasted(card, ctx) {
-
-			creatures := match.Filter(card.Player, ctx.Match, ctx.Match.Opponent(card.Player), match.BATTLEZONE, "Destroy one of your opponent's creatures that has power 4000 or less", 1, 1, false, func(x *match.Card) bool { return x.Power <= 4000 })
-
-			for _, creature := range creatures {
-
-				ctx.Match.Destroy(creature, card)
-
-			}
-
-		}
-
-	})
+	c.Use(fx.Spell, fx.ShieldTrigger, fx.WhenCast(
+		fx.Apply(fx.OpponentsCreatures().Where(fx.PowerAtMost(4000)).Prompt("Destroy one of your opponent's creatures that has power 4000 or less"), fx.DestroyCard),
+	))
 
 }
 
@@ -734,6 +388,7 @@ func UltimateForce(c *match.Card) {
 	c.Civ = civ.Nature
 	c.ManaCost = 5
 	c.ManaRequirement = []string{civ.Nature}
+	c.Text = "Put the top 2 cards of your deck into your mana zone."
 
 	c.Use(fx.Spell, func(card *match.Card, ctx *match.Context) {
 
@@ -761,22 +416,10 @@ func VirtualTripwire(c *match.Card) {
 	c.Civ = civ.Water
 	c.ManaCost = 3
 	c.ManaRequirement = []string{civ.Water}
+	c.Text = "Tap 1 of your opponent's creatures in the battle zone."
 
-	c.Use(fx.Spell, func(card *match.Card, ctx *match.Context) {
-
-		if match.AmICasted(card, ctx) {
-
-			creatures := match.Search(card.Player, ctx.Match, ctx.Match.Opponent(card.Player), match.BATTLEZONE, "Select 1 of your opponent's creatures that will be tapped", 1, 1, false)
-
-			for _, creature := range creatures {
-
-				creature.Tapped = true
-				ctx.Match.Chat("Server", fmt.Sprintf("%s was tapped by %s", creature.Name, card.Name))
-
-			}
-
-		}
-
-	})
+	c.Use(fx.Spell, fx.WhenCast(
+		fx.Apply(fx.OpponentsCreatures().Prompt("Select 1 of your opponent's creatures that will be tapped"), fx.TapCard),
+	))
 
 }
@@ -46,6 +46,7 @@ func ThornyMandra(c *match.Card) {
 	c.Family = family.TreeFolk
 	c.ManaCost = 5
 	c.ManaRequirement = []string{civ.Nature}
+	c.Text = "When you put this creature into the battle zone, you may put a creature from your graveyard into your mana zone."
 
 	c.Use(fx.Creature, func(card *match.Card, ctx *match.Context) {
 
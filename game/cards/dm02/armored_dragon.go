@@ -17,45 +17,28 @@ func BolzardDragon(c *match.Card) {
 	c.Family = family.ArmoredDragon
 	c.ManaCost = 6
 	c.ManaRequirement = []string{civ.Fire}
+	c.Text = "Whenever this creature attacks, put 1 card from your opponent's mana zone into their graveyard."
 
-	c.Use(fx.Creature, func(card *match.Card, ctx *match.Context) {
-
-		if event, ok := ctx.Event.(*match.AttackCreature); ok {
-			if event.CardID == card.ID {
-				bolzardDragonSpecial(card, ctx)
-			}
-		}
-
-		if event, ok := ctx.Event.(*match.AttackPlayer); ok {
-			if event.CardID == card.ID {
-				bolzardDragonSpecial(card, ctx)
-			}
-		}
-
-	})
+	c.Use(fx.Creature, fx.WhenAttacks(bolzardDragonSpecial))
 
 }
 
 func bolzardDragonSpecial(card *match.Card, ctx *match.Context) {
 
-	ctx.ScheduleAfter(func() {
-
-		manaCards := match.Search(
-			card.Player,
-			ctx.Match,
-			ctx.Match.Opponent(card.Player),
-			match.MANAZONE,
-			"Select 1 card from your opponent's mana zone that will be sent to their graveyard",
-			1,
-			1,
-			false,
-		)
-
-		for _, mana := range manaCards {
-			mana.Player.MoveCard(mana.ID, match.MANAZONE, match.GRAVEYARD)
-			ctx.Match.Chat("Server", fmt.Sprintf("%s was sent from %s's manazone to their graveyard by %s", mana.Name, mana.Player.Username(), card.Name))
-		}
-
-	})
+	manaCards := match.Search(
+		card.Player,
+		ctx.Match,
+		ctx.Match.Opponent(card.Player),
+		match.MANAZONE,
+		"Select 1 card from your opponent's mana zone that will be sent to their graveyard",
+		1,
+		1,
+		false,
+	)
+
+	for _, mana := range manaCards {
+		mana.Player.MoveCard(mana.ID, match.MANAZONE, match.GRAVEYARD)
+		ctx.Match.Chat("Server", fmt.Sprintf("%s was sent from %s's manazone to their graveyard by %s", mana.Name, mana.Player.Username(), card.Name))
+	}
 
 }
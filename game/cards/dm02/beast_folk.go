@@ -16,6 +16,7 @@ func BarkwhipTheSmasher(c *match.Card) {
 	c.Family = family.BeastFolk
 	c.ManaCost = 2
 	c.ManaRequirement = []string{civ.Nature}
+	c.Text = "While this creature is tapped, each of your other Beast Folk gets +2000 power."
 
 	c.Use(fx.Creature, fx.Evolution, func(card *match.Card, ctx *match.Context) {
 
@@ -17,6 +17,7 @@ func FortressShell(c *match.Card) {
 	c.Family = family.ColonyBeetle
 	c.ManaCost = 9
 	c.ManaRequirement = []string{civ.Nature}
+	c.Text = "When you put this creature into the battle zone, choose up to 2 cards in your opponent's mana zone and put them into their graveyard."
 
 	c.Use(fx.Creature, func(card *match.Card, ctx *match.Context) {
 
@@ -2,11 +2,9 @@ package dm02
 
 import (
 	"duel-masters/game/civ"
-	"duel-masters/game/cnd"
 	"duel-masters/game/family"
 	"duel-masters/game/fx"
 	"duel-masters/game/match"
-	"fmt"
 )
 
 // RumblingTerahorn ...
@@ -18,22 +16,11 @@ func RumblingTerahorn(c *match.Card) {
 	c.Family = family.HornedBeast
 	c.ManaCost = 5
 	c.ManaRequirement = []string{civ.Nature}
+	c.Text = "When you put this creature into the battle zone, you may search your deck for a creature, show it to your opponent and put it into your hand. Then shuffle your deck."
 
-	c.Use(fx.Creature, func(card *match.Card, ctx *match.Context) {
-
-		if match.AmISummoned(card, ctx) {
-
-			cards := match.SearchForCnd(card.Player, ctx.Match, card.Player, match.DECK, cnd.Creature, "Select 1 creature from your deck that will be shown to your opponent and sent to your hand", 1, 1, true)
-
-			for _, c := range cards {
-				card.Player.MoveCard(c.ID, match.DECK, match.HAND)
-				ctx.Match.Chat("Server", fmt.Sprintf("%s was moved from %s's deck to their hand", c.Name, card.Player.Username()))
-			}
-
-			card.Player.ShuffleDeck()
-
-		}
-
-	})
+	c.Use(fx.Creature, fx.WhenSummoned(
+		fx.Apply(fx.MyCards(match.DECK).Where(fx.IsCreature).Optional().Prompt("Select 1 creature from your deck that will be shown to your opponent and sent to your hand"), fx.ReturnCardToHand),
+		fx.ShuffleDeck,
+	))
 
 }
@@ -17,6 +17,7 @@ func KingNautilus(c *match.Card) {
 	c.Family = family.Leviathan
 	c.ManaCost = 8
 	c.ManaRequirement = []string{civ.Water}
+	c.Text = "Your Liquid People can't be blocked."
 
 	c.Use(fx.Creature, fx.Doublebreaker, func(card *match.Card, ctx *match.Context) {
 
@@ -17,6 +17,7 @@ func EngineerKipo(c *match.Card) {
 	c.Family = family.MachineEater
 	c.ManaCost = 2
 	c.ManaRequirement = []string{civ.Fire}
+	c.Text = "When this creature is destroyed, each player puts 1 card from their mana zone into their graveyard."
 
 	c.Use(fx.Creature, func(card *match.Card, ctx *match.Context) {
 
@@ -51,7 +52,11 @@ func EngineerKipo(c *match.Card) {
 
 					for {
 
-						action := <-p.Action
+						action, ok := <-p.Action
+
+						if !ok {
+							return
+						}
 
 						if len(action.Cards) != toSelect || !match.AssertCardsIn(manazone, action.Cards...) {
 							ctx.Match.DefaultActionWarning(p)
@@ -2,10 +2,8 @@ package dm02
 
 import (
 	"duel-masters/game/civ"
-	"duel-masters/game/cnd"
 	"duel-masters/game/fx"
 	"duel-masters/game/match"
-	"fmt"
 )
 
 // BurstShot ...
@@ -15,6 +13,7 @@ func BurstShot(c *match.Card) {
 	c.Civ = civ.Fire
 	c.ManaCost = 6
 	c.ManaRequirement = []string{civ.Fire}
+	c.Text = "Destroy all creatures that have power 2000 or less."
 
 	c.Use(fx.Spell, fx.ShieldTrigger, func(card *match.Card, ctx *match.Context) {
 
@@ -57,24 +56,11 @@ func LogicCube(c *match.Card) {
 	c.Civ = civ.Light
 	c.ManaCost = 3
 	c.ManaRequirement = []string{civ.Light}
+	c.Text = "Search your deck. You may take a spell from your deck, show it to your opponent, and put it into your hand. Then shuffle your deck."
 
-	c.Use(fx.Spell, fx.ShieldTrigger, func(card *match.Card, ctx *match.Context) {
-
-		if match.AmICasted(card, ctx) {
-
-			creatures := match.Filter(card.Player, ctx.Match, card.Player, match.DECK, "Select 1 spell from your deck that will be shown to your opponent and sent to your hand", 1, 1, false, func(x *match.Card) bool { return x.HasCondition(cnd.Spell) })
-
-			for _, creature := range creatures {
-
-				card.Player.MoveCard(creature.ID, match.DECK, match.HAND)
-				ctx.Match.Chat("Server", fmt.Sprintf("%s retrieved %s from the deck to their hand", card.Player.Username(), creature.Name))
-
-			}
-
-			card.Player.ShuffleDeck()
-
-		}
-
-	})
+	c.Use(fx.Spell, fx.ShieldTrigger, fx.WhenCast(
+		fx.Apply(fx.MyCards(match.DECK).Where(fx.IsSpell).Prompt("Select 1 spell from your deck that will be shown to your opponent and sent to your hand"), fx.ReturnCardToHand),
+		fx.ShuffleDeck,
+	))
 
 }
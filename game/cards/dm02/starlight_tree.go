@@ -16,12 +16,13 @@ func SpiralGrass(c *match.Card) {
 	c.Family = family.StarlightTree
 	c.ManaCost = 4
 	c.ManaRequirement = []string{civ.Light}
+	c.Text = "Whenever this creature blocks and destroys a creature, untap it."
 
 	c.Use(fx.Creature, fx.Blocker, func(card *match.Card, ctx *match.Context) {
 
-		if event, ok := ctx.Event.(*match.CreatureDestroyed); ok {
+		if event, ok := ctx.Event.(*match.BattleWon); ok {
 
-			if event.Source == card && event.Blocked {
+			if event.Winner == card && event.Blocked {
 				card.Tapped = false
 			}
 
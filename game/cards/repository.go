@@ -12,6 +12,13 @@ var Sets = map[string]*map[string]match.CardConstructor{
 	"dm-02": &DM02,
 }
 
+// SetOrder is the order the sets are indexed in deck codes.
+// New sets must only ever be appended, or existing deck codes will decode to different cards
+var SetOrder = []string{
+	"dm-01",
+	"dm-02",
+}
+
 // DM01 is a map with all the card id's in the game and corresponding CardConstructor for dm01
 var DM01 = map[string]match.CardConstructor{
 
@@ -9,6 +9,7 @@ const (
 	PowerAttacker     = "power_attacker"
 	AttackUntapped    = "attack_untapped"
 	Creature          = "creature"
+	Evolution         = "evolution"
 	Spell             = "spell"
 	Blocker           = "blocker"
 	ShieldTrigger     = "shield_trigger"
@@ -16,3 +17,12 @@ const (
 	Active            = "active"
 	CantBeBlocked     = "cant_be_blocked"
 )
+
+// Card markers that only describe what a card does and are never added as a condition
+const (
+	CantAttackPlayers   = "cant_attack_players"
+	CantAttackCreatures = "cant_attack_creatures"
+	ForceAttack         = "force_attack"
+	Suicide             = "suicide"
+	Untap               = "untap"
+)
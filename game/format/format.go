@@ -0,0 +1,200 @@
+package format
+
+import (
+	"fmt"
+	"sort"
+)
+
+// DefaultCopyLimit is the number of copies of a card a deck can contain if the format does not say otherwise
+const DefaultCopyLimit = 4
+
+// Format decides which decks are legal to play
+type Format struct {
+	Name        string   `json:"name"`
+	Sets        []string `json:"sets"`       // Legal sets, all sets are legal if empty
+	CopyLimit   int      `json:"copyLimit"`  // 0 means DefaultCopyLimit
+	Banned      []string `json:"banned"`     // Card uids that cannot be played
+	Restricted  []string `json:"restricted"` // Card uids that can only be played once per deck
+	MinDeckSize int      `json:"minDeckSize"`
+	MaxDeckSize int      `json:"maxDeckSize"`
+}
+
+// Violation describes why a deck is not legal in a format
+type Violation struct {
+	Card    string `json:"card,omitempty"`
+	Message string `json:"message"`
+}
+
+// Formats are the formats a match can be played in
+var Formats = map[string]Format{
+	"standard": {
+		Name:        "standard",
+		Sets:        []string{},
+		CopyLimit:   DefaultCopyLimit,
+		Banned:      []string{},
+		Restricted:  []string{},
+		MinDeckSize: 40,
+		MaxDeckSize: 50,
+	},
+	"dm-01": {
+		Name:        "dm-01",
+		Sets:        []string{"dm-01"},
+		CopyLimit:   DefaultCopyLimit,
+		Banned:      []string{},
+		Restricted:  []string{},
+		MinDeckSize: 40,
+		MaxDeckSize: 50,
+	},
+}
+
+// card holds the information about a card needed to validate decks
+type card struct {
+	name string
+	set  string
+}
+
+var cards = make(map[string]card)
+
+// AddCard registers a card and the set it belongs to
+func AddCard(uid string, name string, set string) {
+	cards[uid] = card{name: name, set: set}
+}
+
+// Default returns the format that is used when nothing else is specified
+func Default() Format {
+	return Formats["standard"]
+}
+
+// MaxDeckSize returns the size of the largest deck that is legal in any format
+func MaxDeckSize() int {
+
+	max := 0
+
+	for _, f := range Formats {
+		if f.MaxDeckSize > max {
+			max = f.MaxDeckSize
+		}
+	}
+
+	return max
+
+}
+
+// Get returns the format with the given name, or false if it does not exist
+func Get(name string) (Format, bool) {
+
+	if name == "" {
+		return Default(), true
+	}
+
+	f, ok := Formats[name]
+
+	return f, ok
+
+}
+
+// Legal returns true if the deck does not violate any of the format's rules
+func (f Format) Legal(deck []string) bool {
+	return len(f.Validate(deck)) < 1
+}
+
+// Validate returns every rule of the format the deck violates
+func (f Format) Validate(deck []string) []Violation {
+
+	violations := make([]Violation, 0)
+
+	if len(deck) < f.MinDeckSize || len(deck) > f.MaxDeckSize {
+		violations = append(violations, Violation{
+			Message: fmt.Sprintf("A deck has to contain between %d and %d cards, this deck contains %d", f.MinDeckSize, f.MaxDeckSize, len(deck)),
+		})
+	}
+
+	copies := make(map[string]int)
+	order := make([]string, 0)
+
+	for _, uid := range deck {
+
+		if copies[uid] < 1 {
+			order = append(order, uid)
+		}
+
+		copies[uid]++
+
+	}
+
+	sort.SliceStable(order, func(i int, j int) bool {
+		return cards[order[i]].name < cards[order[j]].name
+	})
+
+	for _, uid := range order {
+
+		c, ok := cards[uid]
+
+		if !ok {
+			violations = append(violations, Violation{
+				Card:    uid,
+				Message: fmt.Sprintf("%s is not a card", uid),
+			})
+			continue
+		}
+
+		if !f.legalSet(c.set) {
+			violations = append(violations, Violation{
+				Card:    uid,
+				Message: fmt.Sprintf("%s from %s is not legal in the %s format", c.name, c.set, f.Name),
+			})
+			continue
+		}
+
+		if contains(f.Banned, uid) {
+			violations = append(violations, Violation{
+				Card:    uid,
+				Message: fmt.Sprintf("%s is banned in the %s format", c.name, f.Name),
+			})
+			continue
+		}
+
+		if limit := f.copyLimit(uid); copies[uid] > limit {
+			violations = append(violations, Violation{
+				Card:    uid,
+				Message: fmt.Sprintf("A deck can contain at most %d copies of %s, this deck contains %d", limit, c.name, copies[uid]),
+			})
+		}
+
+	}
+
+	return violations
+
+}
+
+// legalSet returns true if cards from the given set can be played in the format
+func (f Format) legalSet(set string) bool {
+	return len(f.Sets) < 1 || contains(f.Sets, set)
+}
+
+// copyLimit returns the number of copies of the card a deck can contain
+func (f Format) copyLimit(uid string) int {
+
+	if contains(f.Restricted, uid) {
+		return 1
+	}
+
+	if f.CopyLimit < 1 {
+		return DefaultCopyLimit
+	}
+
+	return f.CopyLimit
+
+}
+
+func contains(list []string, value string) bool {
+
+	for _, v := range list {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+
+}
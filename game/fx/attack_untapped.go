@@ -8,6 +8,11 @@ import (
 // AttackUntapped allows the card to attack untapped creatures
 func AttackUntapped(card *match.Card, ctx *match.Context) {
 
+	if _, ok := ctx.Event.(*match.InspectCard); ok {
+		card.Mark(cnd.AttackUntapped)
+		card.Describe("This creature can attack untapped creatures.")
+	}
+
 	if _, ok := ctx.Event.(*match.UntapStep); ok {
 
 		card.AddCondition(cnd.AttackUntapped, true, card.ID)
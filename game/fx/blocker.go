@@ -8,6 +8,11 @@ import (
 // Blocker adds the card to a list of blockers when a creature/player is attacked
 func Blocker(card *match.Card, ctx *match.Context) {
 
+	if _, ok := ctx.Event.(*match.InspectCard); ok {
+		card.Mark(cnd.Blocker)
+		card.Describe("Blocker (Whenever an opponent's creature attacks, you may tap this creature to stop the attack. Then the 2 creatures battle.)")
+	}
+
 	if _, ok := ctx.Event.(*match.UntapStep); ok {
 
 		card.AddCondition(cnd.Blocker, true, card.ID)
@@ -1,6 +1,7 @@
 package fx
 
 import (
+	"duel-masters/game/cnd"
 	"duel-masters/game/match"
 	"fmt"
 )
@@ -8,6 +9,11 @@ import (
 // CantAttackPlayers prevents a card from attacking players
 func CantAttackPlayers(card *match.Card, ctx *match.Context) {
 
+	if _, ok := ctx.Event.(*match.InspectCard); ok {
+		card.Mark(cnd.CantAttackPlayers)
+		card.Describe("This creature can't attack players.")
+	}
+
 	if event, ok := ctx.Event.(*match.AttackPlayer); ok {
 
 		// Is this event for me or someone else?
@@ -26,6 +32,11 @@ func CantAttackPlayers(card *match.Card, ctx *match.Context) {
 // CantAttackCreatures prevents a card from attacking players
 func CantAttackCreatures(card *match.Card, ctx *match.Context) {
 
+	if _, ok := ctx.Event.(*match.InspectCard); ok {
+		card.Mark(cnd.CantAttackCreatures)
+		card.Describe("This creature can't attack creatures.")
+	}
+
 	if event, ok := ctx.Event.(*match.AttackCreature); ok {
 
 		// Is this event for me or someone else?
@@ -8,6 +8,11 @@ import (
 // CantBeBlocked allows the card to attack without being blocked
 func CantBeBlocked(card *match.Card, ctx *match.Context) {
 
+	if _, ok := ctx.Event.(*match.InspectCard); ok {
+		card.Mark(cnd.CantBeBlocked)
+		card.Describe("This creature can't be blocked.")
+	}
+
 	if _, ok := ctx.Event.(*match.UntapStep); ok {
 
 		card.AddCondition(cnd.CantBeBlocked, nil, card.ID)
@@ -11,6 +11,10 @@ import (
 // Creature has default behaviours for creatures
 func Creature(card *match.Card, ctx *match.Context) {
 
+	if _, ok := ctx.Event.(*match.InspectCard); ok {
+		card.Mark(cnd.Creature)
+	}
+
 	// Untap the card, add creature condition
 	if _, ok := ctx.Event.(*match.UntapStep); ok {
 
@@ -51,6 +55,21 @@ func Creature(card *match.Card, ctx *match.Context) {
 				return
 			}
 
+			limit := ctx.Match.Rules.BattlezoneLimit
+
+			// Evolution creatures replace the creature they evolve from, so they are not bound by the limit
+			if limit > 0 && !isEvolution(card) {
+
+				battlezone, err := card.Player.Container(match.BATTLEZONE)
+
+				if err == nil && len(battlezone) >= limit {
+					ctx.Match.WarnPlayer(card.Player, fmt.Sprintf("You cannot have more than %v creatures in your battlezone", limit))
+					ctx.InterruptFlow()
+					return
+				}
+
+			}
+
 			ctx.Match.NewAction(
 				card.Player,
 				untappedMana,
@@ -62,7 +81,11 @@ func Creature(card *match.Card, ctx *match.Context) {
 
 			for {
 
-				action := <-card.Player.Action
+				action, ok := <-card.Player.Action
+
+				if !ok {
+					return
+				}
 
 				if action.Cancel {
 					ctx.Match.CloseAction(card.Player)
@@ -161,7 +184,11 @@ func Creature(card *match.Card, ctx *match.Context) {
 
 				for {
 
-					action := <-card.Player.Action
+					action, ok := <-card.Player.Action
+
+					if !ok {
+						return
+					}
 
 					if action.Cancel {
 						ctx.InterruptFlow()
@@ -193,8 +220,14 @@ func Creature(card *match.Card, ctx *match.Context) {
 
 			card.Tapped = true
 
+			ctx.Match.DeclareAttack(card, nil, shieldsAttacked)
+
+			blockers := availableBlockers(opponent, event.Blockers)
+
+			blocked := false
+
 			// Allow the opponent to block if they can
-			if len(event.Blockers) > 0 && !card.HasCondition(cnd.CantBeBlocked) {
+			if len(blockers) > 0 && !card.HasCondition(cnd.CantBeBlocked) {
 
 				ctx.Match.Wait(card.Player, "Waiting for your opponent to make an action")
 
@@ -204,11 +237,15 @@ func Creature(card *match.Card, ctx *match.Context) {
 					identifierStr = fmt.Sprintf("%v of your shields", len(shieldsAttacked))
 				}
 
-				ctx.Match.NewAction(opponent, event.Blockers, 1, 1, fmt.Sprintf("%s (%v) is attacking %s. Choose a creature to block the attack with or close to not block the attack.", card.Name, ctx.Match.GetPower(card, true), identifierStr), true)
+				ctx.Match.NewAction(opponent, blockers, 1, 1, fmt.Sprintf("%s (%v) is attacking %s. Choose a creature to block the attack with or close to not block the attack.", card.Name, ctx.Match.GetPower(card, true), identifierStr), true)
 
 				for {
 
-					action := <-opponent.Action
+					action, ok := <-opponent.Action
+
+					if !ok {
+						return
+					}
 
 					if action.Cancel {
 						ctx.Match.EndWait(card.Player)
@@ -219,13 +256,13 @@ func Creature(card *match.Card, ctx *match.Context) {
 							ctx.Match.End(card.Player, fmt.Sprintf("%s won the game", ctx.Match.PlayerRef(card.Player).Socket.User.Username))
 						} else {
 							// Break n shields
-							ctx.Match.BreakShields(shieldsAttacked)
+							ctx.Match.BreakShields(shieldsAttacked, card)
 						}
 
 						break
 					}
 
-					if len(action.Cards) != 1 || !match.AssertCardsIn(event.Blockers, action.Cards[0]) {
+					if len(action.Cards) != 1 || !match.AssertCardsIn(blockers, action.Cards[0]) {
 						ctx.Match.ActionWarning(opponent, "Your selection of cards does not fulfill the requirements")
 						continue
 					}
@@ -242,6 +279,9 @@ func Creature(card *match.Card, ctx *match.Context) {
 					ctx.Match.EndWait(card.Player)
 					ctx.Match.CloseAction(opponent)
 
+					blocked = true
+
+					ctx.Match.DeclareBlocker(card, c)
 					ctx.Match.Battle(card, c, true)
 
 					break
@@ -257,11 +297,13 @@ func Creature(card *match.Card, ctx *match.Context) {
 					ctx.Match.End(card.Player, fmt.Sprintf("%s won the game", ctx.Match.PlayerRef(card.Player).Socket.User.Username))
 				} else {
 					// Break n shields
-					ctx.Match.BreakShields(shieldsAttacked)
+					ctx.Match.BreakShields(shieldsAttacked, card)
 				}
 
 			}
 
+			ctx.Match.EndAttack(card, nil, blocked)
+
 		})
 
 	}
@@ -310,7 +352,11 @@ func Creature(card *match.Card, ctx *match.Context) {
 
 			for {
 
-				action := <-card.Player.Action
+				action, ok := <-card.Player.Action
+
+				if !ok {
+					return
+				}
 
 				if action.Cancel {
 					ctx.InterruptFlow()
@@ -345,16 +391,33 @@ func Creature(card *match.Card, ctx *match.Context) {
 
 			card.Tapped = true
 
+			ctx.Match.DeclareAttack(card, c, nil)
+
+			// The attacked creature might have left the battlezone when the attack was declared
+			if _, err := opponent.GetCard(c.ID, match.BATTLEZONE); err != nil {
+				ctx.Match.Chat("Server", fmt.Sprintf("%s is no longer in the battlezone, the attack of %s ended", c.Name, card.Name))
+				ctx.Match.EndAttack(card, c, false)
+				return
+			}
+
+			blockers := availableBlockers(opponent, event.Blockers)
+
+			blocked := false
+
 			// Allow the opponent to block if they can
-			if len(event.Blockers) > 0 && !card.HasCondition(cnd.CantBeBlocked) {
+			if len(blockers) > 0 && !card.HasCondition(cnd.CantBeBlocked) {
 
 				ctx.Match.Wait(card.Player, "Waiting for your opponent to make an action")
 
-				ctx.Match.NewAction(opponent, event.Blockers, 1, 1, fmt.Sprintf("%s (%v) is attacking %s (%v). Choose a creature to block the attack with or close to not block the attack.", card.Name, ctx.Match.GetPower(card, true), c.Name, ctx.Match.GetPower(c, false)), true)
+				ctx.Match.NewAction(opponent, blockers, 1, 1, fmt.Sprintf("%s (%v) is attacking %s (%v). Choose a creature to block the attack with or close to not block the attack.", card.Name, ctx.Match.GetPower(card, true), c.Name, ctx.Match.GetPower(c, false)), true)
 
 				for {
 
-					action := <-opponent.Action
+					action, ok := <-opponent.Action
+
+					if !ok {
+						return
+					}
 
 					if action.Cancel {
 						ctx.Match.EndWait(card.Player)
@@ -362,7 +425,7 @@ func Creature(card *match.Card, ctx *match.Context) {
 						break
 					}
 
-					if len(action.Cards) != 1 || !match.AssertCardsIn(event.Blockers, action.Cards[0]) {
+					if len(action.Cards) != 1 || !match.AssertCardsIn(blockers, action.Cards[0]) {
 						ctx.Match.ActionWarning(opponent, "Your selection of cards does not fulfill the requirements")
 						continue
 					}
@@ -379,15 +442,22 @@ func Creature(card *match.Card, ctx *match.Context) {
 					ctx.Match.EndWait(card.Player)
 					ctx.Match.CloseAction(opponent)
 
+					blocked = true
+
+					ctx.Match.DeclareBlocker(card, blocker)
 					ctx.Match.Battle(card, blocker, true)
 
-					return
+					break
 
 				}
 
 			}
 
-			ctx.Match.Battle(card, c, false)
+			if !blocked {
+				ctx.Match.Battle(card, c, false)
+			}
+
+			ctx.Match.EndAttack(card, c, blocked)
 
 		})
 
@@ -422,3 +492,34 @@ func Creature(card *match.Card, ctx *match.Context) {
 	}
 
 }
+
+// isEvolution returns true if the card is an evolution creature, going by the markers of the inspected card
+func isEvolution(card *match.Card) bool {
+
+	inspected, err := match.Inspect(card.ImageID)
+
+	return err == nil && inspected.HasMarker(cnd.Evolution)
+
+}
+
+// availableBlockers returns the blockers that can still block an attack, as the
+// abilities of the attacker might have tapped or removed some of them
+func availableBlockers(opponent *match.Player, blockers []*match.Card) []*match.Card {
+
+	result := make([]*match.Card, 0)
+
+	for _, blocker := range blockers {
+
+		c, err := opponent.GetCard(blocker.ID, match.BATTLEZONE)
+
+		if err != nil || c.Tapped {
+			continue
+		}
+
+		result = append(result, c)
+
+	}
+
+	return result
+
+}
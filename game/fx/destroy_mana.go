@@ -8,6 +8,10 @@ import (
 // DestroyManaOnSummon forces the user to destroy one mana when the card is summoned
 func DestroyManaOnSummon(card *match.Card, ctx *match.Context) {
 
+	if _, ok := ctx.Event.(*match.InspectCard); ok {
+		card.Describe("When you put this creature into the battle zone, put 1 card from your mana zone into your graveyard.")
+	}
+
 	if event, ok := ctx.Event.(*match.CardMoved); ok {
 
 		if event.CardID == card.ID && (event.To == match.BATTLEZONE || event.To == match.SPELLZONE) {
@@ -26,7 +30,11 @@ func DestroyManaOnSummon(card *match.Card, ctx *match.Context) {
 
 			for {
 
-				action := <-card.Player.Action
+				action, ok := <-card.Player.Action
+
+				if !ok {
+					return
+				}
 
 				if len(action.Cards) != 1 || !match.AssertCardsIn(manazone, action.Cards[0]) {
 					ctx.Match.ActionWarning(card.Player, "Your selection of cards does not fulfill the requirements")
@@ -8,6 +8,11 @@ import (
 // Doublebreaker breaks two shields instead of 1 when attacking the player
 func Doublebreaker(card *match.Card, ctx *match.Context) {
 
+	if _, ok := ctx.Event.(*match.InspectCard); ok {
+		card.Mark(cnd.DoubleBreaker)
+		card.Describe("Double breaker (This creature breaks 2 shields.)")
+	}
+
 	if _, ok := ctx.Event.(*match.UntapStep); ok {
 
 		card.AddCondition(cnd.DoubleBreaker, true, card.ID)
@@ -1,12 +1,28 @@
 package fx
 
 import (
+	"duel-masters/game/cnd"
 	"duel-masters/game/match"
 	"fmt"
 )
 
 func draw(card *match.Card, ctx *match.Context, n int) {
 
+	if _, ok := ctx.Event.(*match.InspectCard); ok {
+
+		cards := "1 card"
+		if n > 1 {
+			cards = fmt.Sprintf("%d cards", n)
+		}
+
+		if card.HasMarker(cnd.Spell) {
+			card.Describe(fmt.Sprintf("Draw %s.", cards))
+		} else {
+			card.Describe(fmt.Sprintf("When you put this creature into the battle zone, draw %s.", cards))
+		}
+
+	}
+
 	if event, ok := ctx.Event.(*match.CardMoved); ok {
 
 		if event.CardID == card.ID && (event.To == match.BATTLEZONE || event.To == match.SPELLZONE) {
@@ -47,6 +63,16 @@ func Draw5(card *match.Card, ctx *match.Context) {
 // DrawToMana draws 1 card and puts it in the players manazone
 func DrawToMana(card *match.Card, ctx *match.Context) {
 
+	if _, ok := ctx.Event.(*match.InspectCard); ok {
+
+		if card.HasMarker(cnd.Spell) {
+			card.Describe("Put the top card of your deck into your mana zone.")
+		} else {
+			card.Describe("When you put this creature into the battle zone, put the top card of your deck into your mana zone.")
+		}
+
+	}
+
 	if event, ok := ctx.Event.(*match.CardMoved); ok {
 
 		if event.CardID == card.ID && (event.To == match.BATTLEZONE || event.To == match.SPELLZONE) {
@@ -0,0 +1,287 @@
+package fx
+
+import (
+	"duel-masters/game/cnd"
+	"duel-masters/game/match"
+	"fmt"
+)
+
+// Effect is a part of a card's ability, such as destroying some creatures or drawing cards.
+// Effects are put together into handlers with WhenCast and WhenSummoned, e.g.
+//
+//	c.Use(fx.Spell, fx.WhenCast(fx.Apply(fx.OpponentsCreatures(), fx.DestroyCard)))
+type Effect func(card *match.Card, ctx *match.Context)
+
+// Action is applied to each of the cards selected by Targets
+type Action func(card *match.Card, ctx *match.Context, target *match.Card)
+
+// WhenCast returns a handler that applies the effects in order when the spell is cast
+func WhenCast(effects ...Effect) match.HandlerFunc {
+
+	return func(card *match.Card, ctx *match.Context) {
+
+		if match.AmICasted(card, ctx) {
+			for _, effect := range effects {
+				effect(card, ctx)
+			}
+		}
+
+	}
+
+}
+
+// WhenSummoned returns a handler that applies the effects in order when the creature is put into the battlezone
+func WhenSummoned(effects ...Effect) match.HandlerFunc {
+
+	return func(card *match.Card, ctx *match.Context) {
+
+		if match.AmISummoned(card, ctx) {
+			for _, effect := range effects {
+				effect(card, ctx)
+			}
+		}
+
+	}
+
+}
+
+// WhenAttacks returns a handler that applies the effects in order whenever the creature attacks
+func WhenAttacks(effects ...Effect) match.HandlerFunc {
+
+	return func(card *match.Card, ctx *match.Context) {
+
+		if event, ok := ctx.Event.(*match.AttackDeclared); ok && event.Attacker == card {
+			for _, effect := range effects {
+				effect(card, ctx)
+			}
+		}
+
+	}
+
+}
+
+// WhenBlocks returns a handler that applies the effects in order whenever the creature blocks
+func WhenBlocks(effects ...Effect) match.HandlerFunc {
+
+	return func(card *match.Card, ctx *match.Context) {
+
+		if event, ok := ctx.Event.(*match.BlockerDeclared); ok && event.Blocker == card {
+			for _, effect := range effects {
+				effect(card, ctx)
+			}
+		}
+
+	}
+
+}
+
+// WhenWinsBattle returns a handler that applies the effects in order whenever the creature wins a battle
+func WhenWinsBattle(effects ...Effect) match.HandlerFunc {
+
+	return func(card *match.Card, ctx *match.Context) {
+
+		if event, ok := ctx.Event.(*match.BattleWon); ok && event.Winner == card {
+			for _, effect := range effects {
+				effect(card, ctx)
+			}
+		}
+
+	}
+
+}
+
+// Apply returns an effect that selects the targets and applies the actions to each of them
+func Apply(targets *Targets, actions ...Action) Effect {
+
+	return func(card *match.Card, ctx *match.Context) {
+
+		for _, target := range targets.Select(card, ctx) {
+			for _, action := range actions {
+				action(card, ctx, target)
+			}
+		}
+
+	}
+
+}
+
+// May returns an effect that asks the player whether they want the effects to be applied
+func May(text string, effects ...Effect) Effect {
+
+	return func(card *match.Card, ctx *match.Context) {
+
+		if !match.Confirm(card.Player, ctx.Match, fmt.Sprintf("%s: %s", card.Name, text)) {
+			return
+		}
+
+		for _, effect := range effects {
+			effect(card, ctx)
+		}
+
+	}
+
+}
+
+// DrawCards returns an effect that lets the player draw n cards
+func DrawCards(n int) Effect {
+
+	return func(card *match.Card, ctx *match.Context) {
+		card.Player.DrawCards(n)
+	}
+
+}
+
+// ShuffleDeck shuffles the player's deck
+func ShuffleDeck(card *match.Card, ctx *match.Context) {
+	card.Player.ShuffleDeck()
+}
+
+// DestroyCard destroys the target
+func DestroyCard(card *match.Card, ctx *match.Context, target *match.Card) {
+	ctx.Match.Destroy(target, card)
+}
+
+// ReturnCardToHand puts the target into its owner's hand
+func ReturnCardToHand(card *match.Card, ctx *match.Context, target *match.Card) {
+
+	from := target.Zone
+
+	if _, err := target.Player.MoveCard(target.ID, from, match.HAND); err != nil {
+		return
+	}
+
+	ctx.Match.Chat("Server", fmt.Sprintf("%s was moved from %s's %s to their hand by %s", target.Name, target.Player.Username(), from, card.Name))
+
+}
+
+// PutCardIntoMana puts the target untapped into its owner's manazone
+func PutCardIntoMana(card *match.Card, ctx *match.Context, target *match.Card) {
+
+	from := target.Zone
+
+	if _, err := target.Player.MoveCard(target.ID, from, match.MANAZONE); err != nil {
+		return
+	}
+
+	target.Tapped = false
+
+	ctx.Match.Chat("Server", fmt.Sprintf("%s was moved from %s's %s to their manazone by %s", target.Name, target.Player.Username(), from, card.Name))
+
+}
+
+// PutCardOnTopOfDeck puts the target on top of its owner's deck
+func PutCardOnTopOfDeck(card *match.Card, ctx *match.Context, target *match.Card) {
+
+	from := target.Zone
+
+	if _, err := target.Player.MoveCardToTop(target.ID, from, match.DECK); err != nil {
+		return
+	}
+
+	ctx.Match.Chat("Server", fmt.Sprintf("%s was put on top of %s's deck by %s", target.Name, target.Player.Username(), card.Name))
+
+}
+
+// PutCardOnBottomOfDeck puts the target at the bottom of its owner's deck
+func PutCardOnBottomOfDeck(card *match.Card, ctx *match.Context, target *match.Card) {
+
+	from := target.Zone
+
+	if _, err := target.Player.MoveCardToBottom(target.ID, from, match.DECK); err != nil {
+		return
+	}
+
+	ctx.Match.Chat("Server", fmt.Sprintf("%s was put at the bottom of %s's deck by %s", target.Name, target.Player.Username(), card.Name))
+
+}
+
+// PutCardIntoShields puts the target face down into its owner's shieldzone
+func PutCardIntoShields(card *match.Card, ctx *match.Context, target *match.Card) {
+
+	from := target.Zone
+
+	if _, err := target.Player.AddShield(target.ID, from); err != nil {
+		return
+	}
+
+	name := target.Name
+
+	// Cards from hidden zones stay hidden
+	if from == match.HAND || from == match.DECK {
+		name = "A card"
+	}
+
+	ctx.Match.Chat("Server", fmt.Sprintf("%s was put from %s's %s into their shieldzone by %s", name, target.Player.Username(), from, card.Name))
+
+}
+
+// DiscardCard puts the target from its owner's hand into their graveyard
+func DiscardCard(card *match.Card, ctx *match.Context, target *match.Card) {
+
+	if _, err := target.Player.MoveCard(target.ID, match.HAND, match.GRAVEYARD); err != nil {
+		return
+	}
+
+	ctx.Match.Chat("Server", fmt.Sprintf("%s was discarded from %s's hand", target.Name, target.Player.Username()))
+
+}
+
+// TapCard taps the target
+func TapCard(card *match.Card, ctx *match.Context, target *match.Card) {
+
+	target.Tapped = true
+
+	ctx.Match.Chat("Server", fmt.Sprintf("%s was tapped by %s", target.Name, card.Name))
+
+}
+
+// UntapCard untaps the target
+func UntapCard(card *match.Card, ctx *match.Context, target *match.Card) {
+
+	target.Tapped = false
+
+	ctx.Match.Chat("Server", fmt.Sprintf("%s was untapped by %s", target.Name, card.Name))
+
+}
+
+// AddPower returns an action that gives the target "power attacker +n" until the end of the turn
+func AddPower(n int) Action {
+
+	return func(card *match.Card, ctx *match.Context, target *match.Card) {
+
+		target.AddCondition(cnd.PowerAttacker, n, card.ID)
+
+		ctx.Match.Chat("Server", fmt.Sprintf("%s was given power attacker +%d by %s", target.Name, n, card.Name))
+
+	}
+
+}
+
+// Names of the conditions that can be given with Give, used for the chat
+var conditionNames = map[string]string{
+	cnd.DoubleBreaker:  "double breaker",
+	cnd.TripleBreaker:  "triple breaker",
+	cnd.AttackUntapped: "attack untapped",
+	cnd.Blocker:        "blocker",
+	cnd.Slayer:         "slayer",
+	cnd.CantBeBlocked:  "can't be blocked",
+}
+
+// Give returns an action that gives the condition to the target until the end of the turn
+func Give(condition string) Action {
+
+	name, ok := conditionNames[condition]
+
+	if !ok {
+		name = condition
+	}
+
+	return func(card *match.Card, ctx *match.Context, target *match.Card) {
+
+		target.AddCondition(condition, nil, card.ID)
+
+		ctx.Match.Chat("Server", fmt.Sprintf("%s was given \"%s\" by %s", target.Name, name, card.Name))
+
+	}
+
+}
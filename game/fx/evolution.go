@@ -40,6 +40,11 @@ targeted/leaving the field, etc.
 // Evolution has default behaviour for evolution cards according to the rules commented above
 func Evolution(card *match.Card, ctx *match.Context) {
 
+	if _, ok := ctx.Event.(*match.InspectCard); ok {
+		card.Mark(cnd.Evolution)
+		card.Describe(fmt.Sprintf("Evolution - Put on one of your %s.", card.Family))
+	}
+
 	if event, ok := ctx.Event.(*match.PlayCardEvent); ok {
 
 		if event.CardID != card.ID {
@@ -9,6 +9,11 @@ import (
 // ForceAttack prevents the user from ending their turn if the card has not attacked this turn
 func ForceAttack(card *match.Card, ctx *match.Context) {
 
+	if _, ok := ctx.Event.(*match.InspectCard); ok {
+		card.Mark(cnd.ForceAttack)
+		card.Describe("This creature attacks each turn if able.")
+	}
+
 	if _, ok := ctx.Event.(*match.EndTurnEvent); ok && card.Zone == match.BATTLEZONE {
 
 		if ctx.Match.IsPlayerTurn(card.Player) && !card.HasCondition(cnd.SummoningSickness) && !card.Tapped {
@@ -3,10 +3,16 @@ package fx
 import (
 	"duel-masters/game/cnd"
 	"duel-masters/game/match"
+	"fmt"
 )
 
 func powerAttacker(card *match.Card, ctx *match.Context, n int) {
 
+	if _, ok := ctx.Event.(*match.InspectCard); ok {
+		card.Mark(cnd.PowerAttacker)
+		card.Describe(fmt.Sprintf("Power attacker +%d (While attacking, this creature gets +%d power.)", n, n))
+	}
+
 	if _, ok := ctx.Event.(*match.UntapStep); ok {
 
 		if ctx.Match.IsPlayerTurn(card.Player) {
@@ -8,6 +8,10 @@ import (
 // ReturnToHand returns the card to the players hand instead of the graveyard
 func ReturnToHand(card *match.Card, ctx *match.Context) {
 
+	if _, ok := ctx.Event.(*match.InspectCard); ok {
+		card.Describe("When this creature would be destroyed, return it to your hand instead.")
+	}
+
 	// When destroyed
 	if event, ok := ctx.Event.(*match.CreatureDestroyed); ok {
 
@@ -27,6 +31,10 @@ func ReturnToHand(card *match.Card, ctx *match.Context) {
 // ReturnToMana returns the card to the players manazone instead of the graveyard
 func ReturnToMana(card *match.Card, ctx *match.Context) {
 
+	if _, ok := ctx.Event.(*match.InspectCard); ok {
+		card.Describe("When this creature would be destroyed, put it into your mana zone instead.")
+	}
+
 	// When destroyed
 	if event, ok := ctx.Event.(*match.CreatureDestroyed); ok {
 
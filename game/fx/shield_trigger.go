@@ -8,6 +8,11 @@ import (
 // ShieldTrigger returns the card to the players hand instead of the graveyard
 func ShieldTrigger(card *match.Card, ctx *match.Context) {
 
+	if _, ok := ctx.Event.(*match.InspectCard); ok {
+		card.Mark(cnd.ShieldTrigger)
+		card.Describe("Shield trigger (When this card is put into your hand from your shield zone, you may use it immediately for no cost.)")
+	}
+
 	if _, ok := ctx.Event.(*match.UntapStep); ok {
 
 		card.AddCondition(cnd.ShieldTrigger, nil, nil)
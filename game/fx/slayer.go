@@ -8,6 +8,11 @@ import (
 // Slayer destroys the source card when the card is destroyed
 func Slayer(card *match.Card, ctx *match.Context) {
 
+	if _, ok := ctx.Event.(*match.InspectCard); ok {
+		card.Mark(cnd.Slayer)
+		card.Describe("Slayer (Whenever this creature battles, destroy the other creature after the battle.)")
+	}
+
 	if _, ok := ctx.Event.(*match.UntapStep); ok {
 		card.AddCondition(cnd.Slayer, nil, card.ID)
 	}
@@ -17,19 +22,17 @@ func Slayer(card *match.Card, ctx *match.Context) {
 // Suicide destroys the card when it wins a battle
 func Suicide(card *match.Card, ctx *match.Context) {
 
-	// When destroyed
-	if event, ok := ctx.Event.(*match.CreatureDestroyed); ok {
-
-		if event.Source == card {
-
-			creature, err := card.Player.GetCard(event.Source.ID, match.BATTLEZONE)
-
-			if err == nil {
+	if _, ok := ctx.Event.(*match.InspectCard); ok {
+		card.Mark(cnd.Suicide)
+		card.Describe("Whenever this creature wins a battle, destroy it after the battle.")
+	}
 
-				ctx.Match.Destroy(creature, event.Card)
+	if event, ok := ctx.Event.(*match.BattleWon); ok && event.Winner == card {
 
-			}
+		creature, err := card.Player.GetCard(card.ID, match.BATTLEZONE)
 
+		if err == nil {
+			ctx.Match.Destroy(creature, event.Loser)
 		}
 
 	}
@@ -1,6 +1,7 @@
 package fx
 
 import (
+	"duel-masters/game/cnd"
 	"duel-masters/game/match"
 	"fmt"
 )
@@ -8,6 +9,10 @@ import (
 // Spell has default functionality for spells
 func Spell(card *match.Card, ctx *match.Context) {
 
+	if _, ok := ctx.Event.(*match.InspectCard); ok {
+		card.Mark(cnd.Spell)
+	}
+
 	// When the spell is played from hand
 	if event, ok := ctx.Event.(*match.PlayCardEvent); ok {
 
@@ -48,7 +53,11 @@ func Spell(card *match.Card, ctx *match.Context) {
 
 			for {
 
-				action := <-card.Player.Action
+				action, ok := <-card.Player.Action
+
+				if !ok {
+					return
+				}
 
 				if action.Cancel {
 					ctx.Match.CloseAction(card.Player)
@@ -0,0 +1,221 @@
+package fx
+
+import (
+	"duel-masters/game/cnd"
+	"duel-masters/game/match"
+	"fmt"
+	"math/rand"
+)
+
+// Targets describes which cards an effect is applied to: whose cards, from which zone,
+// which of them and how many. Build them with MyCards, OpponentsCards or AnyCards, e.g.
+//
+//	fx.OpponentsCreatures().Where(fx.PowerAtMost(2000)).Choose(1, 1)
+type Targets struct {
+	opponent    bool
+	both        bool
+	zone        string
+	min         int
+	max         int
+	all         bool
+	random      bool
+	cancellable bool
+	filters     []func(*match.Card) bool
+	text        string
+}
+
+// MyCards targets 1 card of the player's own cards in the given zone
+func MyCards(zone string) *Targets {
+	return &Targets{zone: zone, min: 1, max: 1}
+}
+
+// OpponentsCards targets 1 card of the opponent's cards in the given zone
+func OpponentsCards(zone string) *Targets {
+	return &Targets{opponent: true, zone: zone, min: 1, max: 1}
+}
+
+// AnyCards targets 1 card in the given zone of either player
+func AnyCards(zone string) *Targets {
+	return &Targets{both: true, zone: zone, min: 1, max: 1}
+}
+
+// MyCreatures targets 1 creature in the player's own battlezone
+func MyCreatures() *Targets {
+	return MyCards(match.BATTLEZONE)
+}
+
+// OpponentsCreatures targets 1 creature in the opponent's battlezone
+func OpponentsCreatures() *Targets {
+	return OpponentsCards(match.BATTLEZONE)
+}
+
+// AnyCreatures targets 1 creature in the battlezone of either player
+func AnyCreatures() *Targets {
+	return AnyCards(match.BATTLEZONE)
+}
+
+// Choose lets the player select between min and max of the cards
+func (t *Targets) Choose(min int, max int) *Targets {
+	t.min, t.max = min, max
+	t.all, t.random = false, false
+	return t
+}
+
+// All targets every card without asking the player
+func (t *Targets) All() *Targets {
+	t.all, t.random = true, false
+	return t
+}
+
+// Random targets n of the cards at random without asking the player
+func (t *Targets) Random(n int) *Targets {
+	t.min, t.max = n, n
+	t.all, t.random = false, true
+	return t
+}
+
+// Where only targets cards that match the filter, it can be used more than once
+func (t *Targets) Where(filter func(*match.Card) bool) *Targets {
+	t.filters = append(t.filters, filter)
+	return t
+}
+
+// Optional lets the player close the selection without choosing any card
+func (t *Targets) Optional() *Targets {
+	t.cancellable = true
+	return t
+}
+
+// Prompt sets the text that is shown to the player when selecting the cards
+func (t *Targets) Prompt(text string) *Targets {
+	t.text = text
+	return t
+}
+
+// matches returns true if the card passes all filters
+func (t *Targets) matches(card *match.Card) bool {
+
+	for _, filter := range t.filters {
+		if !filter(card) {
+			return false
+		}
+	}
+
+	return true
+
+}
+
+// Select returns the targeted cards, prompting the player of the card when they have to choose
+func (t *Targets) Select(card *match.Card, ctx *match.Context) []*match.Card {
+
+	result := make([]*match.Card, 0)
+
+	zones := t.zones(card, ctx)
+
+	if !t.all && !t.random {
+
+		text := t.text
+
+		if text == "" {
+			text = t.defaultPrompt(card)
+		}
+
+		if !t.both {
+			return match.Filter(card.Player, ctx.Match, zones[0].Owner, t.zone, text, t.min, t.max, t.cancellable, t.matches)
+		}
+
+		for _, selection := range match.SearchZones(card.Player, ctx.Match, zones, text, t.min, t.max, t.cancellable, t.matches) {
+			result = append(result, selection.Card)
+		}
+
+		return result
+
+	}
+
+	for _, zone := range zones {
+
+		cards, err := zone.Owner.Container(zone.Name)
+
+		if err != nil {
+			continue
+		}
+
+		for _, c := range cards {
+			if t.matches(c) {
+				result = append(result, c)
+			}
+		}
+
+	}
+
+	if t.random {
+
+		rand.Shuffle(len(result), func(i, j int) { result[i], result[j] = result[j], result[i] })
+
+		if len(result) > t.max {
+			result = result[:t.max]
+		}
+
+	}
+
+	return result
+
+}
+
+// zones returns the zones the targets are selected from
+func (t *Targets) zones(card *match.Card, ctx *match.Context) []match.Zone {
+
+	if t.both {
+		return match.BothZones(card.Player, ctx.Match, t.zone)
+	}
+
+	if t.opponent {
+		return []match.Zone{{Owner: ctx.Match.Opponent(card.Player), Name: t.zone}}
+	}
+
+	return []match.Zone{{Owner: card.Player, Name: t.zone}}
+
+}
+
+// defaultPrompt describes the selection when the card has no prompt of its own
+func (t *Targets) defaultPrompt(card *match.Card) string {
+
+	owner := "your"
+
+	if t.opponent {
+		owner = "your opponent's"
+	}
+
+	if t.both {
+		owner = "any"
+	}
+
+	count := fmt.Sprintf("%d", t.min)
+
+	if t.max != t.min {
+		count = fmt.Sprintf("%d to %d", t.min, t.max)
+	}
+
+	return fmt.Sprintf("%s: Select %s card(s) from %s %s", card.Name, count, owner, t.zone)
+
+}
+
+// PowerAtMost filters cards that have the given power or less, without power modifiers
+func PowerAtMost(n int) func(*match.Card) bool {
+	return func(x *match.Card) bool { return x.Power <= n }
+}
+
+// IsUntapped filters untapped cards
+func IsUntapped(x *match.Card) bool {
+	return !x.Tapped
+}
+
+// IsCreature filters creatures
+func IsCreature(x *match.Card) bool {
+	return x.HasCondition(cnd.Creature)
+}
+
+// IsSpell filters spells
+func IsSpell(x *match.Card) bool {
+	return x.HasCondition(cnd.Spell)
+}
@@ -0,0 +1,91 @@
+package match
+
+import (
+	"duel-masters/server"
+	"fmt"
+	"time"
+)
+
+// Seconds an mping can stay unanswered before a player is considered to have lost their connection
+const pongTimeout = 60
+
+// MarkActive resets the inactivity timer of the player
+func (p *PlayerReference) MarkActive() {
+	p.LastActive = time.Now().Unix()
+	p.idleWarned = false
+}
+
+// isGameAction returns true for the messages that count as the player making an action,
+// chatting or answering pings does not keep a player from being inactive
+func isGameAction(header string) bool {
+
+	if header == "action" {
+		return true
+	}
+
+	_, ok := turnActions[header]
+
+	return ok
+
+}
+
+// awaitedPlayer returns the player the match is currently waiting for.
+// That is the player with an open action prompt, or otherwise the player whose turn it is
+func (m *Match) awaitedPlayer() *PlayerReference {
+
+	if m.Player1.prompted {
+		return m.Player1
+	}
+
+	if m.Player2.prompted {
+		return m.Player2
+	}
+
+	return m.CurrentPlayer()
+
+}
+
+// checkActivity pings both players and forfeits the duel for a player that
+// has stopped responding or has not made an action for too long
+func (m *Match) checkActivity() {
+
+	now := time.Now().Unix()
+
+	for _, p := range []*PlayerReference{m.Player1, m.Player2} {
+
+		if p.pingSent > 0 && now-p.pingSent > pongTimeout {
+			opponent := m.Opponent(p.Player)
+			m.end(opponent, fmt.Sprintf("%s lost their connection, %s won the game", p.Socket.User.Username, opponent.Username()), EndReasonDisconnect)
+			return
+		}
+
+		if p.pingSent == 0 {
+			p.pingSent = now
+		}
+
+		p.Socket.Send(server.Message{Header: "mping"})
+
+	}
+
+	timeout := int64(m.Rules.InactivityTimeout)
+
+	if timeout < 1 {
+		return
+	}
+
+	p := m.awaitedPlayer()
+
+	idle := now - p.LastActive
+
+	if idle > timeout {
+		opponent := m.Opponent(p.Player)
+		m.end(opponent, fmt.Sprintf("%s was inactive for too long and forfeited the duel, %s won the game", p.Socket.User.Username, opponent.Username()), EndReasonInactivity)
+		return
+	}
+
+	if idle > timeout/2 && !p.idleWarned {
+		p.idleWarned = true
+		Warn(p, fmt.Sprintf("You have been inactive for a while. You will forfeit the duel if you do not make an action within %v seconds", timeout-idle))
+	}
+
+}
@@ -27,10 +27,14 @@ type Card struct {
 	ManaCost        int
 	ManaRequirement []string
 	PowerModifier   func(m *Match, attacking bool) int
+	Text            string // Rules text of the card's own abilities, the fx describe themselves
 
 	attachedCards []*Card
 	conditions    []Condition
 	handlers      []HandlerFunc
+	markers       []string
+	rulesText     []string
+	undescribed   int
 }
 
 // NewCard returns a new, initialized card
@@ -43,20 +47,9 @@ func NewCard(p *Player, image string) (*Card, error) {
 		return nil, err
 	}
 
-	c := &Card{
-		ID:              id,
-		ImageID:         image,
-		Player:          p,
-		Tapped:          false,
-		Zone:            DECK,
-		Name:            "undefined_card",
-		Power:           0,
-		Civ:             "undefind_civ",
-		Family:          "undefined_family",
-		ManaCost:        1,
-		ManaRequirement: make([]string, 0),
-		PowerModifier:   func(m *Match, attacking bool) int { return 0 },
-	}
+	c := defaultCard(image)
+	c.ID = id
+	c.Player = p
 
 	cardctor, err := CardCtor(image)
 
@@ -71,6 +64,24 @@ func NewCard(p *Player, image string) (*Card, error) {
 
 }
 
+// defaultCard returns a card with the default values every card constructor starts with
+func defaultCard(image string) *Card {
+
+	return &Card{
+		ImageID:         image,
+		Tapped:          false,
+		Zone:            DECK,
+		Name:            "undefined_card",
+		Power:           0,
+		Civ:             "undefind_civ",
+		Family:          "undefined_family",
+		ManaCost:        1,
+		ManaRequirement: make([]string, 0),
+		PowerModifier:   func(m *Match, attacking bool) int { return 0 },
+	}
+
+}
+
 // Use allows different cards to hook into match events
 // Can be compared to a typical middleware function
 func (c *Card) Use(handlers ...HandlerFunc) {
@@ -0,0 +1,139 @@
+package match
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"duel-masters/server"
+)
+
+// arrange takes the cards with the given ids out of the container and returns them in the
+// given order together with the cards that are left, in their original order
+func arrange(container []*Card, ids []string) ([]*Card, []*Card, error) {
+
+	arranged := make([]*Card, 0)
+	rest := make([]*Card, 0)
+
+	for _, id := range ids {
+
+		found := false
+
+		for _, card := range container {
+			if card.ID == id {
+				arranged = append(arranged, card)
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return nil, nil, errors.New("Card is not in the specified container")
+		}
+
+	}
+
+	for _, card := range container {
+
+		picked := false
+
+		for _, id := range ids {
+			if card.ID == id {
+				picked = true
+			}
+		}
+
+		if !picked {
+			rest = append(rest, card)
+		}
+
+	}
+
+	if len(arranged)+len(rest) != len(container) {
+		return nil, nil, errors.New("The same card can only be arranged once")
+	}
+
+	return arranged, rest, nil
+
+}
+
+// ArrangeTop puts the cards with the given ids on top of the container in the given order, the first
+// one on top. The cards stay in the same container, so no CardMoved events are fired
+func (p *Player) ArrangeTop(container string, ids []string) error {
+
+	c, err := p.ContainerRef(container)
+
+	if err != nil {
+		return err
+	}
+
+	p.mutex.Lock()
+
+	defer p.mutex.Unlock()
+
+	arranged, rest, err := arrange(*c, ids)
+
+	if err != nil {
+		return err
+	}
+
+	*c = append(arranged, rest...)
+
+	return nil
+
+}
+
+// ArrangeBottom puts the cards with the given ids at the bottom of the container in the given order,
+// the last one at the very bottom. The cards stay in the same container, so no CardMoved events are fired
+func (p *Player) ArrangeBottom(container string, ids []string) error {
+
+	c, err := p.ContainerRef(container)
+
+	if err != nil {
+		return err
+	}
+
+	p.mutex.Lock()
+
+	defer p.mutex.Unlock()
+
+	arranged, rest, err := arrange(*c, ids)
+
+	if err != nil {
+		return err
+	}
+
+	*c = append(rest, arranged...)
+
+	return nil
+
+}
+
+// ShowCards shows the cards to the player in a popup
+func (m *Match) ShowCards(p *Player, message string, cards []*Card) {
+
+	m.PlayerRef(p).Socket.Send(server.ShowCardsMessage{
+		Header:  "show_cards",
+		Message: message,
+		Cards:   denormalizeCards(cards, false),
+	})
+
+}
+
+// Reveal shows the player's cards to their opponent
+func (m *Match) Reveal(p *Player, cards []*Card) {
+
+	if len(cards) < 1 {
+		return
+	}
+
+	names := make([]string, 0)
+
+	for _, card := range cards {
+		names = append(names, card.Name)
+	}
+
+	m.ShowCards(m.Opponent(p), fmt.Sprintf("%s revealed", p.Username()), cards)
+	m.Chat("Server", fmt.Sprintf("%s revealed %s", p.Username(), strings.Join(names, ", ")))
+
+}
@@ -24,6 +24,20 @@ type CardMoved struct {
 	CardID string
 	From   string
 	To     string
+	ToTop  bool // The card was put on top of the container rather than at its bottom
+}
+
+// ShieldBroken is fired after a shield was broken and put into its owner's hand, before its shield trigger
+// is used. Shields that leave the shieldzone in any other way only fire CardMoved
+type ShieldBroken struct {
+	CardID string
+	Source *Card // The card that broke the shield, usually the attacking creature
+}
+
+// ShieldAdded is fired after a card was put face down into the shieldzone
+type ShieldAdded struct {
+	CardID string
+	From   string
 }
 
 // SpellCast is fired when a spell is cast, either from being played or from shield triggers
@@ -44,6 +58,42 @@ type AttackCreature struct {
 	Blockers []*Card
 }
 
+// AttackDeclared is fired once an attack can no longer be cancelled, after the attacker was tapped and before
+// a blocker is chosen. Unlike AttackPlayer and AttackCreature it is only fired for attacks that happen
+type AttackDeclared struct {
+	Attacker *Card
+	Target   *Card   // The attacked creature, nil if the player is attacked
+	Shields  []*Card // The shields that will be broken if the player is attacked and the attack is not blocked
+}
+
+// BlockerDeclared is fired when a creature blocks an attack, right before the battle
+type BlockerDeclared struct {
+	Attacker *Card
+	Blocker  *Card
+}
+
+// AttackEnded is fired after an attack was resolved, whether it was blocked, led to a battle or broke shields
+type AttackEnded struct {
+	Attacker *Card
+	Target   *Card // The attacked creature, nil if the player was attacked
+	Blocked  bool
+}
+
+// BattleWon is fired after a battle for the creature that had more power than the other one
+type BattleWon struct {
+	Winner  *Card
+	Loser   *Card
+	Blocked bool
+}
+
+// BattleLost is fired after a battle for each creature that did not have more power than the other one,
+// when both have the same power both of them lose
+type BattleLost struct {
+	Loser   *Card
+	Winner  *Card // The other creature of the battle, which may have lost as well
+	Blocked bool
+}
+
 // Battle is fired when two creatures are fighting, i.e. from attacking a creature or blocking an attack
 type Battle struct {
 	Attacker *Card
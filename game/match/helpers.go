@@ -1,5 +1,7 @@
 package match
 
+import "fmt"
+
 // AssertCardsIn returns true or false based on if the specified card ids are present in the source []*Card
 func AssertCardsIn(src []*Card, test ...string) bool {
 
@@ -40,7 +42,11 @@ func Search(p *Player, m *Match, containerOwner *Player, containerName string, t
 
 	for {
 
-		action := <-p.Action
+		action, ok := <-p.Action
+
+		if !ok {
+			return result
+		}
 
 		if cancellable && action.Cancel {
 			break
@@ -99,7 +105,11 @@ func SearchForCnd(p *Player, m *Match, containerOwner *Player, containerName str
 
 	for {
 
-		action := <-p.Action
+		action, ok := <-p.Action
+
+		if !ok {
+			return result
+		}
 
 		if cancellable && action.Cancel {
 			break
@@ -158,7 +168,11 @@ func SearchForFamily(p *Player, m *Match, containerOwner *Player, containerName
 
 	for {
 
-		action := <-p.Action
+		action, ok := <-p.Action
+
+		if !ok {
+			return result
+		}
 
 		if cancellable && action.Cancel {
 			break
@@ -217,7 +231,11 @@ func Filter(p *Player, m *Match, containerOwner *Player, containerName string, t
 
 	for {
 
-		action := <-p.Action
+		action, ok := <-p.Action
+
+		if !ok {
+			return result
+		}
 
 		if cancellable && action.Cancel {
 			break
@@ -248,6 +266,299 @@ func Filter(p *Player, m *Match, containerOwner *Player, containerName string, t
 
 }
 
+// Zone is a container of a specific player that cards can be selected from
+type Zone struct {
+	Owner *Player
+	Name  string
+}
+
+// Selection is a card that was selected from a zone, together with the zone it is in
+type Selection struct {
+	Card  *Card
+	Owner *Player
+	Zone  string
+}
+
+// Labels of the zones in multipart prompts
+var zoneLabels = map[string]string{
+	DECK:       "deck",
+	HAND:       "hand",
+	SHIELDZONE: "shields",
+	MANAZONE:   "manazone",
+	GRAVEYARD:  "graveyard",
+	BATTLEZONE: "creatures",
+}
+
+// label returns the name of the zone in the eyes of the given player, e.g. "Opponent's creatures"
+func (z Zone) label(p *Player) string {
+
+	name, ok := zoneLabels[z.Name]
+
+	if !ok {
+		name = z.Name
+	}
+
+	if z.Owner == p {
+		return "Your " + name
+	}
+
+	return "Opponent's " + name
+
+}
+
+// BothZones returns the zone with the given name of the player and of their opponent
+func BothZones(p *Player, m *Match, zone string) []Zone {
+	return []Zone{{Owner: p, Name: zone}, {Owner: m.Opponent(p), Name: zone}}
+}
+
+// SearchZones prompts the user to select n cards that match the given filter from any of the zones.
+// The cards are grouped by zone, a nil filter matches all cards
+func SearchZones(p *Player, m *Match, zones []Zone, text string, min int, max int, cancellable bool, filter func(*Card) bool) []Selection {
+
+	result := make([]Selection, 0)
+
+	groups := make(map[string][]*Card)
+	candidates := make(map[string]Selection)
+
+	for _, zone := range zones {
+
+		cards, err := zone.Owner.Container(zone.Name)
+
+		if err != nil {
+			continue
+		}
+
+		label := zone.label(p)
+
+		if _, ok := groups[label]; !ok {
+			groups[label] = make([]*Card, 0)
+		}
+
+		for _, card := range cards {
+
+			if filter != nil && !filter(card) {
+				continue
+			}
+
+			groups[label] = append(groups[label], card)
+			candidates[card.ID] = Selection{Card: card, Owner: zone.Owner, Zone: zone.Name}
+
+		}
+
+	}
+
+	if len(candidates) < 1 {
+		return result
+	}
+
+	m.NewMultipartAction(p, groups, min, max, text, cancellable)
+
+	defer m.CloseAction(p)
+
+	for {
+
+		action, ok := <-p.Action
+
+		if !ok {
+			return result
+		}
+
+		if cancellable && action.Cancel {
+			break
+		}
+
+		valid := len(action.Cards) >= min && len(action.Cards) <= max
+
+		for _, id := range action.Cards {
+			if _, ok := candidates[id]; !ok {
+				valid = false
+			}
+		}
+
+		if !valid {
+			m.DefaultActionWarning(p)
+			continue
+		}
+
+		for _, id := range action.Cards {
+
+			selection := candidates[id]
+
+			// The card might have left the zone while the player was choosing
+			if _, err := selection.Owner.GetCard(id, selection.Zone); err != nil {
+				continue
+			}
+
+			result = append(result, selection)
+
+		}
+
+		break
+
+	}
+
+	return result
+
+}
+
+// Confirm prompts the user to answer the text with yes or no and returns true if they answered yes
+func Confirm(p *Player, m *Match, text string) bool {
+
+	m.NewConfirmAction(p, text)
+
+	defer m.CloseAction(p)
+
+	action, ok := <-p.Action
+
+	if !ok {
+		return false
+	}
+
+	return action.Confirm && !action.Cancel
+
+}
+
+// ChooseMode prompts the user to choose one of the modes and returns its index, or -1 if they cancelled
+func ChooseMode(p *Player, m *Match, text string, modes []string, cancellable bool) int {
+
+	if len(modes) < 1 {
+		return -1
+	}
+
+	m.NewModeAction(p, modes, text, cancellable)
+
+	defer m.CloseAction(p)
+
+	for {
+
+		action, ok := <-p.Action
+
+		if !ok {
+			return -1
+		}
+
+		if cancellable && action.Cancel {
+			return -1
+		}
+
+		if action.Cancel || action.Mode < 0 || action.Mode >= len(modes) {
+			m.ActionWarning(p, "You must choose one of the modes")
+			continue
+		}
+
+		return action.Mode
+
+	}
+
+}
+
+// ChooseNumber prompts the user to choose a number between min and max, the second
+// return value is false if they cancelled
+func ChooseNumber(p *Player, m *Match, text string, min int, max int, cancellable bool) (int, bool) {
+
+	m.NewNumberAction(p, min, max, text, cancellable)
+
+	defer m.CloseAction(p)
+
+	for {
+
+		action, ok := <-p.Action
+
+		if !ok {
+			return 0, false
+		}
+
+		if cancellable && action.Cancel {
+			return 0, false
+		}
+
+		if action.Cancel || action.Number < min || action.Number > max {
+			m.ActionWarning(p, fmt.Sprintf("You must choose a number between %d and %d", min, max))
+			continue
+		}
+
+		return action.Number, true
+
+	}
+
+}
+
+// Arrange prompts the user to put the cards in order by selecting all of them, the first one selected
+// comes first. The cards are returned in the chosen order
+func Arrange(p *Player, m *Match, cards []*Card, text string) []*Card {
+
+	if len(cards) < 2 {
+		return cards
+	}
+
+	m.NewAction(p, cards, len(cards), len(cards), text, false)
+
+	defer m.CloseAction(p)
+
+	for {
+
+		action, ok := <-p.Action
+
+		if !ok {
+			return cards
+		}
+
+		if len(action.Cards) != len(cards) || !AssertCardsIn(cards, action.Cards...) {
+			m.ActionWarning(p, "You must select all the cards in the order you want them in")
+			continue
+		}
+
+		result := make([]*Card, 0)
+
+		for _, id := range action.Cards {
+			for _, card := range cards {
+				if card.ID == id {
+					result = append(result, card)
+				}
+			}
+		}
+
+		return result
+
+	}
+
+}
+
+// RearrangeTopOfDeck lets the user look at the top n cards of their deck and put them back in any order
+func RearrangeTopOfDeck(p *Player, m *Match, n int, text string) {
+
+	cards := Arrange(p, m, p.PeekDeck(n), text)
+
+	ids := make([]string, 0)
+
+	for _, card := range cards {
+		ids = append(ids, card.ID)
+	}
+
+	p.ArrangeTop(DECK, ids)
+
+}
+
+// SearchDeck prompts the user to select n cards that match the given filter from their deck. The selected
+// cards are revealed to the opponent if reveal is true, and the deck is shuffled afterwards
+func SearchDeck(p *Player, m *Match, text string, min int, max int, cancellable bool, reveal bool, filter func(*Card) bool) []*Card {
+
+	if filter == nil {
+		filter = func(*Card) bool { return true }
+	}
+
+	cards := Filter(p, m, p, DECK, text, min, max, cancellable, filter)
+
+	if reveal {
+		m.Reveal(p, cards)
+	}
+
+	p.ShuffleDeck()
+
+	return cards
+
+}
+
 // ContainerHas returns true or false based on if the specified container includes a card that matches the given filter
 func ContainerHas(p *Player, containerName string, filter func(*Card) bool) bool {
 
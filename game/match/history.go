@@ -0,0 +1,102 @@
+package match
+
+import (
+	"context"
+	"duel-masters/db"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// saveResult stores the record of the finished match in the matches collection
+func (m *Match) saveResult() {
+
+	if !m.Started || m.winner == nil {
+		return
+	}
+
+	ended := time.Now().Unix()
+	started := m.startedAt
+	turns := m.TurnNumber
+	games := 1
+
+	// Sets are stored as a single record covering all of their games
+	if m.Set != nil {
+		started = m.Set.startedAt
+		turns = m.Set.turns
+		games = m.Set.games
+	}
+
+	record := db.Match{
+		UID:        uuid.New().String(),
+		Name:       m.MatchName,
+		Players:    []db.MatchPlayer{m.Player1.record(), m.Player2.record()},
+		Winner:     m.PlayerRef(m.winner).Socket.User.UID,
+		Reason:     m.endReason,
+		Turns:      turns,
+		Games:      games,
+		Ranked:     m.Ranked,
+		Rules:      m.Rules.Name,
+		Tournament: m.Tournament,
+		Started:    started,
+		Ended:      ended,
+		Duration:   ended - started,
+	}
+
+	if _, err := db.Collection("matches").InsertOne(context.TODO(), record); err != nil {
+		logrus.Error(err)
+		return
+	}
+
+	logrus.Debugf("Saved the result of match %s", m.ID)
+
+}
+
+// record returns the information about the player that is stored in the match history
+func (p *PlayerReference) record() db.MatchPlayer {
+
+	return db.MatchPlayer{
+		Wins:          p.Player.match.setWins(p),
+		UID:           p.Socket.User.UID,
+		Username:      p.Socket.User.Username,
+		Deck:          p.Player.ChosenDeck.UID,
+		DeckName:      p.Player.ChosenDeck.Name,
+		Civilizations: DeckCivilizations(p.Player.ChosenDeck.Cards),
+	}
+
+}
+
+// DeckCivilizations returns the unique civilizations of the given card uids
+func DeckCivilizations(cards []string) []string {
+
+	result := make([]string, 0)
+
+	for _, uid := range cards {
+
+		ctor, err := CardCtor(uid)
+
+		if err != nil {
+			continue
+		}
+
+		card := &Card{}
+		ctor(card)
+
+		duplicate := false
+
+		for _, civ := range result {
+			if civ == card.Civ {
+				duplicate = true
+			}
+		}
+
+		if !duplicate {
+			result = append(result, card.Civ)
+		}
+
+	}
+
+	return result
+
+}
@@ -0,0 +1,149 @@
+package match
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+)
+
+// InspectCard is fired on a card that is inspected outside of a match.
+// Fx handle it by marking the card with what they do, e.g. that it is a creature or a shield trigger
+type InspectCard struct{}
+
+// Mark adds markers to the card describing what it is and what it does, they never change during a match
+func (c *Card) Mark(markers ...string) {
+
+	for _, marker := range markers {
+		if !c.HasMarker(marker) {
+			c.markers = append(c.markers, marker)
+		}
+	}
+
+}
+
+// HasMarker returns true if the card has been marked with the given marker
+func (c *Card) HasMarker(marker string) bool {
+
+	for _, m := range c.markers {
+		if m == marker {
+			return true
+		}
+	}
+
+	return false
+
+}
+
+// Markers returns the markers of the card
+func (c *Card) Markers() []string {
+	return c.markers
+}
+
+// Inspect returns the card with the given uid as created by its constructor, outside of any
+// match. Its handlers are passed an InspectCard event so the card carries the markers of its fx
+func Inspect(uid string) (*Card, error) {
+
+	ctor, err := CardCtor(uid)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return InspectConstructor(uid, ctor), nil
+
+}
+
+// InspectConstructor inspects the card created by the given constructor, whether or not it has been added
+func InspectConstructor(uid string, ctor CardConstructor) *Card {
+
+	c := defaultCard(uid)
+	c.markers = make([]string, 0)
+
+	ctor(c)
+
+	c.rulesText = make([]string, 0)
+
+	ctx := NewContext(nil, &InspectCard{})
+
+	for _, h := range c.handlers {
+
+		markers, lines := len(c.markers), len(c.rulesText)
+
+		inspectWith(c, h, ctx)
+
+		if len(c.markers) == markers && len(c.rulesText) == lines {
+			c.undescribed++
+		}
+
+	}
+
+	return c
+
+}
+
+// inspectWith passes the inspection to a single handler. There's no match during an
+// inspection, so handlers that use it without checking the event first are skipped
+func inspectWith(c *Card, h HandlerFunc, ctx *Context) {
+
+	defer func() {
+		if r := recover(); r != nil {
+			logrus.Debugf("Handler of %s failed during the inspection. %v", c.Name, r)
+		}
+	}()
+
+	h(c, ctx)
+
+}
+
+// Describe adds a line to the rules text of the card, fx describe what they do when the card is inspected
+func (c *Card) Describe(text string) {
+	c.rulesText = append(c.rulesText, text)
+}
+
+// RulesText returns the lines of the card's rules text, the ones of its fx followed by its own
+func (c *Card) RulesText() []string {
+
+	result := make([]string, 0)
+	result = append(result, c.rulesText...)
+
+	for _, line := range strings.Split(c.Text, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			result = append(result, line)
+		}
+	}
+
+	return result
+
+}
+
+// MissingRulesText returns true if the card has handlers that do not describe what they do
+// and the card has no text of its own. Only inspected cards know about their handlers
+func (c *Card) MissingRulesText() bool {
+	return c.undescribed > 0 && strings.TrimSpace(c.Text) == ""
+}
+
+var rulesText = make(map[string][]string)
+var rulesTextMutex = sync.Mutex{}
+
+// RulesText returns the rules text of the card with the given uid
+func RulesText(uid string) []string {
+
+	rulesTextMutex.Lock()
+	defer rulesTextMutex.Unlock()
+
+	if text, ok := rulesText[uid]; ok {
+		return text
+	}
+
+	card, err := Inspect(uid)
+
+	if err != nil {
+		return []string{}
+	}
+
+	rulesText[uid] = card.RulesText()
+
+	return rulesText[uid]
+
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"duel-masters/db"
 	"duel-masters/game/cnd"
+	"duel-masters/game/format"
 	"duel-masters/server"
 	"encoding/json"
 	"errors"
@@ -37,19 +38,46 @@ var lobbyMatches = make(chan server.MatchesListMessage)
 
 // Match struct
 type Match struct {
-	ID        string           `json:"id"`
-	MatchName string           `json:"name"`
-	HostID    string           `json:"-"`
-	Player1   *PlayerReference `json:"-"`
-	Player2   *PlayerReference `json:"-"`
-	Turn      byte             `json:"-"`
-	Started   bool             `json:"started"`
-	Visible   bool             `json:"visible"`
-
-	created int64
-	ending  bool
-
-	quit chan bool
+	ID         string           `json:"id"`
+	MatchName  string           `json:"name"`
+	HostID     string           `json:"-"`
+	GuestID    string           `json:"-"`
+	Player1    *PlayerReference `json:"-"`
+	Player2    *PlayerReference `json:"-"`
+	Turn       byte             `json:"-"`
+	TurnNumber int              `json:"-"`
+	Phase      Phase            `json:"-"`
+	Started    bool             `json:"started"`
+	Visible    bool             `json:"visible"`
+	Ranked     bool             `json:"ranked"`
+	Rules      Rules            `json:"rules"`
+
+	// PresetDecks maps user uids to the deck they will play with, skipping the deck selection
+	PresetDecks map[string]string `json:"-"`
+
+	// Tournament is the uid of the tournament the match is part of, if any
+	Tournament string `json:"-"`
+
+	// OnEnd is called with the uid of the winner once the match has been decided
+	OnEnd func(winner string) `json:"-"`
+
+	// Set is the set the match is a game of, if any
+	Set *Set `json:"-"`
+
+	// FirstPlayer is the uid of the user that goes first, it is chosen randomly if empty
+	FirstPlayer string `json:"-"`
+
+	created   int64
+	startedAt int64
+	ending    bool
+	winner    *Player
+	endReason string
+
+	quit     chan bool
+	quitOnce sync.Once
+	rematch  chan bool
+	first    chan bool
+	left     chan *PlayerReference
 }
 
 // Matches returns a list of the current matches
@@ -64,7 +92,7 @@ func Matches() []string {
 }
 
 // New returns a new match object
-func New(matchName string, hostID string, visible bool) *Match {
+func New(matchName string, hostID string, visible bool, rules Rules) *Match {
 
 	id, err := shortid.Generate()
 
@@ -77,13 +105,20 @@ func New(matchName string, hostID string, visible bool) *Match {
 		MatchName: matchName,
 		HostID:    hostID,
 		Turn:      1,
+		Phase:     PhaseIdle,
 		Started:   false,
 		Visible:   visible,
+		Rules:     rules,
+
+		PresetDecks: make(map[string]string),
 
 		created: time.Now().Unix(),
 		ending:  false,
 
-		quit: make(chan bool),
+		quit:    make(chan bool, 1),
+		rematch: make(chan bool, 1),
+		first:   make(chan bool, 1),
+		left:    make(chan *PlayerReference, 1),
 	}
 
 	matchesMutex.Lock()
@@ -128,6 +163,11 @@ func UpdateMatchList() {
 			continue
 		}
 
+		// The second seat is reserved for a specific player
+		if match.GuestID != "" {
+			continue
+		}
+
 		// remove this when spectating is out
 		if match.Player2 != nil {
 			continue
@@ -141,12 +181,18 @@ func UpdateMatchList() {
 			continue
 		}
 
+		bestOf := 0
+		if match.Set != nil {
+			bestOf = match.Set.BestOf
+		}
+
 		matchesMessage = append(matchesMessage, server.MatchMessage{
 			ID:       match.ID,
 			Owner:    match.Player1.Socket.User.Username,
 			Color:    match.Player1.Socket.User.Color,
 			Name:     match.MatchName,
 			Spectate: match.Started,
+			BestOf:   bestOf,
 		})
 	}
 
@@ -188,6 +234,10 @@ func (m *Match) startTicker() {
 					return
 				}
 
+				if m.Started && !m.ending {
+					m.checkActivity()
+				}
+
 			}
 		}
 
@@ -217,8 +267,6 @@ func (m *Match) Dispose() {
 
 	matchesMutex.Lock()
 
-	close(m.quit)
-
 	delete(matches, m.ID)
 
 	matchesMutex.Unlock()
@@ -260,11 +308,11 @@ func (m *Match) CurrentPlayer() *PlayerReference {
 // PlayerForSocket returns the player ref for a given socker or an error if the socket is not p1 or p2
 func (m *Match) PlayerForSocket(s *server.Socket) (*PlayerReference, error) {
 
-	if m.Player1.Socket == s {
+	if m.Player1 != nil && m.Player1.Socket == s {
 		return m.Player1, nil
 	}
 
-	if m.Player2.Socket == s {
+	if m.Player2 != nil && m.Player2.Socket == s {
 		return m.Player2, nil
 	}
 
@@ -339,6 +387,10 @@ func (m *Match) GetPower(card *Card, isAttacking bool) int {
 // CastSpell Fires a SpellCast event
 func (m *Match) CastSpell(card *Card, fromShield bool) {
 
+	if !fromShield {
+		card.Player.Stats.CardsPlayed++
+	}
+
 	m.HandleFx(NewContext(m, &SpellCast{
 		CardID:     card.ID,
 		FromShield: fromShield,
@@ -357,32 +409,87 @@ func (m *Match) Battle(attacker *Card, defender *Card, blocked bool) {
 	m.HandleFx(NewContext(m, &Battle{Attacker: attacker, Defender: defender, Blocked: blocked}))
 
 	if attackerPower > defenderPower {
+		m.countDestroyed(defender, attacker)
 		m.HandleFx(NewContext(m, &CreatureDestroyed{Card: defender, Source: attacker, Blocked: blocked}))
 		m.Chat("Server", fmt.Sprintf("%s (%v) was destroyed by %s (%v)", defender.Name, defenderPower, attacker.Name, attackerPower))
+		m.battleResult(attacker, defender, blocked)
 	} else if attackerPower == defenderPower {
+		m.countDestroyed(attacker, defender)
 		m.HandleFx(NewContext(m, &CreatureDestroyed{Card: attacker, Source: defender, Blocked: blocked}))
 		m.Chat("Server", fmt.Sprintf("%s (%v) was destroyed by %s (%v)", attacker.Name, attackerPower, defender.Name, defenderPower))
+		m.countDestroyed(defender, attacker)
 		m.HandleFx(NewContext(m, &CreatureDestroyed{Card: defender, Source: attacker, Blocked: blocked}))
 		m.Chat("Server", fmt.Sprintf("%s (%v) was destroyed by %s (%v)", defender.Name, defenderPower, attacker.Name, attackerPower))
+		m.HandleFx(NewContext(m, &BattleLost{Loser: attacker, Winner: defender, Blocked: blocked}))
+		m.HandleFx(NewContext(m, &BattleLost{Loser: defender, Winner: attacker, Blocked: blocked}))
 	} else if attackerPower < defenderPower {
+		m.countDestroyed(attacker, defender)
 		m.HandleFx(NewContext(m, &CreatureDestroyed{Card: attacker, Source: defender, Blocked: blocked}))
 		m.Chat("Server", fmt.Sprintf("%s (%v) was destroyed by %s (%v)", attacker.Name, attackerPower, defender.Name, defenderPower))
+		m.battleResult(defender, attacker, blocked)
 	}
 
 	m.BroadcastState()
 
 }
 
+// battleResult lets the creatures of a battle know that it was decided
+func (m *Match) battleResult(winner *Card, loser *Card, blocked bool) {
+
+	m.HandleFx(NewContext(m, &BattleWon{Winner: winner, Loser: loser, Blocked: blocked}))
+	m.HandleFx(NewContext(m, &BattleLost{Loser: loser, Winner: winner, Blocked: blocked}))
+
+}
+
+// DeclareAttack lets the cards know that the attacker is now attacking the target, or the player if the target is nil.
+// The turn only moves on to the attack phase here, as the attack can still be cancelled before
+func (m *Match) DeclareAttack(attacker *Card, target *Card, shields []*Card) {
+
+	m.ProceedTo(PhaseAttack)
+
+	m.HandleFx(NewContext(m, &AttackDeclared{Attacker: attacker, Target: target, Shields: shields}))
+
+}
+
+// DeclareBlocker lets the cards know that the blocker blocks the attack of the attacker
+func (m *Match) DeclareBlocker(attacker *Card, blocker *Card) {
+	m.HandleFx(NewContext(m, &BlockerDeclared{Attacker: attacker, Blocker: blocker}))
+}
+
+// EndAttack lets the cards know that the attack is over, unless it ended the match
+func (m *Match) EndAttack(attacker *Card, target *Card, blocked bool) {
+
+	if m.ending {
+		return
+	}
+
+	m.HandleFx(NewContext(m, &AttackEnded{Attacker: attacker, Target: target, Blocked: blocked}))
+
+}
+
 // Destroy sends the given card to its players graveyard
 func (m *Match) Destroy(card *Card, source *Card) {
 
+	m.countDestroyed(card, source)
+
 	m.HandleFx(NewContext(m, &CreatureDestroyed{Card: card, Source: source}))
 	m.Chat("Server", fmt.Sprintf("%s (%v) was destroyed by %s", card.Name, m.GetPower(card, false), source.Name))
 
 }
 
-// BreakShields breaks the given shields and handles shieldtriggers
-func (m *Match) BreakShields(shields []*Card) {
+// countDestroyed adds a destroyed creature to the stats of the player that destroyed it
+func (m *Match) countDestroyed(card *Card, source *Card) {
+
+	if source == nil || source.Player == nil || source.Player == card.Player {
+		return
+	}
+
+	source.Player.Stats.CreaturesDestroyed++
+
+}
+
+// BreakShields breaks the given shields and handles shieldtriggers, the source is the card that breaks them
+func (m *Match) BreakShields(shields []*Card, source *Card) {
 
 	if len(shields) < 1 {
 		return
@@ -398,31 +505,15 @@ func (m *Match) BreakShields(shields []*Card) {
 			continue
 		}
 
-		// Handle shield triggers
-		if card.HasCondition(cnd.ShieldTrigger) {
-
-			m.NewAction(card.Player, []*Card{card}, 1, 1, "Shield trigger! Choose the spell to cast it for free or close to keep it in your hand", true)
+		m.Opponent(card.Player).Stats.ShieldsBroken++
 
-			for {
+		m.HandleFx(NewContext(m, &ShieldBroken{CardID: card.ID, Source: source}))
 
-				action := <-card.Player.Action
-
-				if action.Cancel {
-					m.CloseAction(card.Player)
-					break
-				}
-
-				if len(action.Cards) < 1 {
-					m.DefaultActionWarning(card.Player)
-					continue
-				}
+		// Handle shield triggers
+		if card.HasCondition(cnd.ShieldTrigger) {
 
+			if Confirm(card.Player, m, fmt.Sprintf("Shield trigger! Do you want to cast %s for free? Otherwise it is kept in your hand", card.Name)) {
 				m.CastSpell(card, true)
-
-				m.CloseAction(card.Player)
-
-				break
-
 			}
 
 		}
@@ -431,25 +522,9 @@ func (m *Match) BreakShields(shields []*Card) {
 
 }
 
-// End ends the match
+// End ends the match with the given player as the winner
 func (m *Match) End(winner *Player, winnerStr string) {
-
-	logrus.Debugf("Attempting to end match %s", m.ID)
-
-	if m.ending {
-		logrus.Debugf("Cannot end match, %s is already ending", m.ID)
-		return
-	}
-
-	m.ending = true
-
-	if m.Started {
-		WarnError(m.PlayerRef(winner), winnerStr)
-		WarnError(m.PlayerRef(m.Opponent(winner)), winnerStr)
-	}
-
-	m.quit <- true
-
+	m.end(winner, winnerStr, EndReasonWin)
 }
 
 // ColorChat sends a chat message with color
@@ -481,6 +556,8 @@ func (m *Match) BroadcastState() {
 		State: server.MatchState{
 			MyTurn:       m.Turn == 1,
 			HasAddedMana: m.Player1.Player.HasChargedMana,
+			TurnNumber:   m.TurnNumber,
+			Step:         string(m.Phase),
 			Me:           player1,
 			Opponent:     player2,
 		},
@@ -491,6 +568,8 @@ func (m *Match) BroadcastState() {
 		State: server.MatchState{
 			MyTurn:       m.Turn == 2,
 			HasAddedMana: m.Player2.Player.HasChargedMana,
+			TurnNumber:   m.TurnNumber,
+			Step:         string(m.Phase),
 			Me:           player2,
 			Opponent:     player1,
 		},
@@ -615,6 +694,7 @@ func (m *Match) NewAction(player *Player, cards []*Card, minSelections int, maxS
 		Cancellable:   cancellable,
 	}
 
+	m.prompt(player)
 	m.PlayerRef(player).Socket.Send(msg)
 
 }
@@ -631,6 +711,7 @@ func (m *Match) NewBacksideAction(player *Player, cards []*Card, minSelections i
 		Cancellable:   cancellable,
 	}
 
+	m.prompt(player)
 	m.PlayerRef(player).Socket.Send(msg)
 
 }
@@ -653,12 +734,65 @@ func (m *Match) NewMultipartAction(player *Player, cards map[string][]*Card, min
 		Cancellable:   cancellable,
 	}
 
+	m.prompt(player)
+	m.PlayerRef(player).Socket.Send(msg)
+
+}
+
+// NewConfirmAction prompts the user to answer the text with yes or no
+func (m *Match) NewConfirmAction(player *Player, text string) {
+
+	msg := &server.ConfirmActionMessage{
+		Header: "confirm_action",
+		Text:   text,
+	}
+
+	m.prompt(player)
+	m.PlayerRef(player).Socket.Send(msg)
+
+}
+
+// NewModeAction prompts the user to choose one of the modes
+func (m *Match) NewModeAction(player *Player, modes []string, text string, cancellable bool) {
+
+	msg := &server.ModeActionMessage{
+		Header:      "mode_action",
+		Text:        text,
+		Modes:       modes,
+		Cancellable: cancellable,
+	}
+
+	m.prompt(player)
 	m.PlayerRef(player).Socket.Send(msg)
 
 }
 
+// NewNumberAction prompts the user to choose a number between min and max
+func (m *Match) NewNumberAction(player *Player, min int, max int, text string, cancellable bool) {
+
+	msg := &server.NumberActionMessage{
+		Header:      "number_action",
+		Text:        text,
+		Min:         min,
+		Max:         max,
+		Cancellable: cancellable,
+	}
+
+	m.prompt(player)
+	m.PlayerRef(player).Socket.Send(msg)
+
+}
+
+// prompt marks the player as having an open action and resets their inactivity timer
+func (m *Match) prompt(p *Player) {
+	ref := m.PlayerRef(p)
+	ref.prompted = true
+	ref.MarkActive()
+}
+
 // CloseAction closes the card selection popup for the given player
 func (m *Match) CloseAction(p *Player) {
+	m.PlayerRef(p).prompted = false
 	m.PlayerRef(p).Socket.Send(server.Message{
 		Header: "close_action",
 	})
@@ -679,25 +813,81 @@ func (m *Match) EndWait(p *Player) {
 	})
 }
 
+// ChooseDeck loads the deck with the given uid for the player and starts the match once both players are ready
+func (m *Match) ChooseDeck(p *PlayerReference, uid string) {
+
+	if m.Started {
+		Warn(p, "You cannot choose a deck after the duel has started")
+		return
+	}
+
+	if p.Player.Ready {
+		Warn(p, "You have already chosen your deck")
+		return
+	}
+
+	var deck db.Deck
+
+	if err := db.Collection("decks").FindOne(context.TODO(), bson.M{"uid": uid}).Decode(&deck); err != nil {
+		return
+	}
+
+	f, ok := format.Get(m.Rules.Format)
+
+	if !ok {
+		logrus.Warnf("Match %s uses the format %s which does not exist", m.ID, m.Rules.Format)
+		f = format.Default()
+	}
+
+	if violations := f.Validate(deck.Cards); len(violations) > 0 {
+		Warn(p, fmt.Sprintf("%s is not legal in the %s format: %s", deck.Name, f.Name, violations[0].Message))
+		return
+	}
+
+	p.Player.CreateDeck(deck.Cards)
+	p.Player.ChosenDeck = deck
+
+	m.Chat("Server", fmt.Sprintf("%s has chosen their deck", p.Socket.User.Username))
+
+	p.Player.Ready = true
+
+	if m.Player1.Player.Ready && m.Player2.Player.Ready {
+		m.Start()
+	}
+
+}
+
 // Start starts the match
 func (m *Match) Start() {
 
 	m.Started = true
+	m.startedAt = time.Now().Unix()
+
+	// Waiting for the opponent or the deck choices does not count towards the activity checks
+	for _, p := range []*PlayerReference{m.Player1, m.Player2} {
+		p.LastPong = m.startedAt
+		p.pingSent = 0
+		p.MarkActive()
+	}
 
 	UpdateMatchList()
 
 	m.Player1.Player.ShuffleDeck()
 	m.Player2.Player.ShuffleDeck()
 
-	m.Player1.Player.InitShieldzone()
-	m.Player2.Player.InitShieldzone()
+	m.Player1.Player.InitShieldzone(m.Rules.StartingShields)
+	m.Player2.Player.InitShieldzone(m.Rules.StartingShields)
 
-	m.Player1.Player.DrawCards(5)
-	m.Player2.Player.DrawCards(5)
+	m.Player1.Player.DrawCards(m.Rules.StartingHand)
+	m.Player2.Player.DrawCards(m.Rules.StartingHand)
 
 	// match.turn is initialized as 1, so we only need to change it to 2
 	// The opposite of what's defined here will start because BeginNewTurn() changes it
-	if rand.Intn(100) >= 50 {
+	if m.FirstPlayer != "" {
+		if m.FirstPlayer == m.Player1.Socket.User.UID {
+			m.Turn = 2
+		}
+	} else if rand.Intn(100) >= 50 {
 		m.Turn = 2
 	}
 
@@ -716,12 +906,15 @@ func (m *Match) BeginNewTurn() {
 		m.Turn = 1
 	}
 
+	m.TurnNumber++
+	m.Phase = PhaseBeginTurn
+
 	ctx := NewContext(m, &BeginTurnStep{})
 
 	m.HandleFx(ctx)
 
 	m.CurrentPlayer().Player.HasChargedMana = false
-	m.CurrentPlayer().Player.CanChargeMana = true
+	m.CurrentPlayer().MarkActive()
 
 	m.BroadcastState()
 
@@ -732,6 +925,8 @@ func (m *Match) BeginNewTurn() {
 // UntapStep ...
 func (m *Match) UntapStep() {
 
+	m.Phase = PhaseUntap
+
 	if mana, err := m.CurrentPlayer().Player.Container(MANAZONE); err == nil {
 		for _, c := range mana {
 			c.Tapped = false
@@ -749,6 +944,8 @@ func (m *Match) UntapStep() {
 // StartOfTurnStep ...
 func (m *Match) StartOfTurnStep() {
 
+	m.Phase = PhaseStartOfTurn
+
 	ctx := NewContext(m, &StartOfTurnStep{})
 
 	m.HandleFx(ctx)
@@ -762,11 +959,18 @@ func (m *Match) StartOfTurnStep() {
 // DrawStep ...
 func (m *Match) DrawStep() {
 
+	m.Phase = PhaseDraw
+
 	ctx := NewContext(m, &DrawStep{})
 
 	m.HandleFx(ctx)
 
-	m.CurrentPlayer().Player.DrawCards(1)
+	// The player that starts the duel does not draw on their first turn
+	if m.TurnNumber == 1 && m.Rules.SkipFirstDraw {
+		m.Chat("Server", fmt.Sprintf("%s skips their first draw", m.CurrentPlayer().Socket.User.Username))
+	} else {
+		m.CurrentPlayer().Player.DrawCards(1)
+	}
 
 	m.BroadcastState()
 
@@ -777,15 +981,66 @@ func (m *Match) DrawStep() {
 // ChargeStep ...
 func (m *Match) ChargeStep() {
 
+	m.Phase = PhaseCharge
+
 	ctx := NewContext(m, &ChargeStep{})
 
 	m.HandleFx(ctx)
 
+	m.BroadcastState()
+
+}
+
+// MainStep ...
+func (m *Match) MainStep() {
+
+	m.Phase = PhaseMain
+
+	ctx := NewContext(m, &MainStep{})
+
+	m.HandleFx(ctx)
+
+	m.BroadcastState()
+
+}
+
+// AttackStep ...
+func (m *Match) AttackStep() {
+
+	m.Phase = PhaseAttack
+
+	ctx := NewContext(m, &AttackStep{})
+
+	m.HandleFx(ctx)
+
+	m.BroadcastState()
+
+}
+
+// ProceedTo moves the turn forward to the given phase, firing each of the steps in between.
+// The turn can only move forward, so nothing happens if the phase has already passed
+func (m *Match) ProceedTo(phase Phase) {
+
+	for m.Phase != phase {
+
+		switch m.Phase {
+		case PhaseCharge:
+			m.MainStep()
+		case PhaseMain:
+			m.AttackStep()
+		default:
+			return
+		}
+
+	}
+
 }
 
 // EndStep ...
 func (m *Match) EndStep() {
 
+	m.Phase = PhaseEnd
+
 	ctx := NewContext(m, &EndStep{})
 
 	m.HandleFx(ctx)
@@ -822,6 +1077,7 @@ func (m *Match) EndTurn() {
 	m.HandleFx(ctx)
 
 	if !ctx.cancel {
+		m.ProceedTo(PhaseAttack)
 		m.EndStep()
 	}
 
@@ -835,15 +1091,10 @@ func (m *Match) ChargeMana(p *PlayerReference, cardID string) {
 		return
 	}
 
-	if !p.Player.CanChargeMana {
-		Warn(p, "You can't charge mana after playing or attacking with creatures/spells")
-		return
-	}
-
 	if card, err := p.Player.MoveCard(cardID, HAND, MANAZONE); err == nil {
 		p.Player.HasChargedMana = true
-		m.BroadcastState()
 		m.Chat("Server", fmt.Sprintf("%s was added to %s's manazone", card.Name, p.Socket.User.Username))
+		m.ProceedTo(PhaseMain)
 	}
 
 }
@@ -851,7 +1102,7 @@ func (m *Match) ChargeMana(p *PlayerReference, cardID string) {
 // PlayCard is called when the player attempts to play a card
 func (m *Match) PlayCard(p *PlayerReference, cardID string) {
 
-	p.Player.CanChargeMana = false
+	m.ProceedTo(PhaseMain)
 
 	m.HandleFx(NewContext(m, &PlayCardEvent{
 		CardID: cardID,
@@ -864,8 +1115,6 @@ func (m *Match) PlayCard(p *PlayerReference, cardID string) {
 // AttackPlayer is called when the player attempts to attack the opposing player
 func (m *Match) AttackPlayer(p *PlayerReference, cardID string) {
 
-	p.Player.CanChargeMana = false
-
 	_, err := p.Player.GetCard(cardID, BATTLEZONE)
 
 	if err != nil {
@@ -885,8 +1134,6 @@ func (m *Match) AttackPlayer(p *PlayerReference, cardID string) {
 // AttackCreature is called when the player attempts to attack the opposing player
 func (m *Match) AttackCreature(p *PlayerReference, cardID string) {
 
-	p.Player.CanChargeMana = false
-
 	_, err := p.Player.GetCard(cardID, BATTLEZONE)
 
 	if err != nil {
@@ -917,6 +1164,12 @@ func (m *Match) Parse(s *server.Socket, data []byte) {
 		return
 	}
 
+	if isGameAction(message.Header) {
+		if p, err := m.PlayerForSocket(s); err == nil {
+			p.MarkActive()
+		}
+	}
+
 	switch message.Header {
 
 	case "mpong":
@@ -929,6 +1182,7 @@ func (m *Match) Parse(s *server.Socket, data []byte) {
 			}
 
 			p.LastPong = time.Now().Unix()
+			p.pingSent = 0
 
 		}
 
@@ -945,6 +1199,15 @@ func (m *Match) Parse(s *server.Socket, data []byte) {
 				return
 			}
 
+			if m.GuestID != "" && s.User.UID != m.HostID && s.User.UID != m.GuestID {
+				s.Send(server.WarningMessage{
+					Header:  "error",
+					Message: "This match is reserved for another player, you cannot join it.",
+				})
+				s.Close()
+				return
+			}
+
 			// This is player1
 			if s.User.UID == m.HostID {
 
@@ -1026,17 +1289,26 @@ func (m *Match) Parse(s *server.Socket, data []byte) {
 
 				}
 
-				m.Player1.Socket.Send(server.DecksMessage{
-					Header: "choose_deck",
-					Decks:  player1decks,
-				})
+				m.Chat("Server", "Waiting for both players to choose a deck")
 
-				m.Player2.Socket.Send(server.DecksMessage{
-					Header: "choose_deck",
-					Decks:  player2decks,
-				})
+				// Players that chose their deck before the match was created, i.e. from the queue, skip the prompt
+				if deck, ok := m.PresetDecks[m.Player1.Socket.User.UID]; ok {
+					m.ChooseDeck(m.Player1, deck)
+				} else {
+					m.Player1.Socket.Send(server.DecksMessage{
+						Header: "choose_deck",
+						Decks:  player1decks,
+					})
+				}
 
-				m.Chat("Server", "Waiting for both players to choose a deck")
+				if deck, ok := m.PresetDecks[m.Player2.Socket.User.UID]; ok {
+					m.ChooseDeck(m.Player2, deck)
+				} else {
+					m.Player2.Socket.Send(server.DecksMessage{
+						Header: "choose_deck",
+						Decks:  player2decks,
+					})
+				}
 
 			}
 
@@ -1099,21 +1371,7 @@ func (m *Match) Parse(s *server.Socket, data []byte) {
 				return
 			}
 
-			var deck db.Deck
-
-			if err := db.Collection("decks").FindOne(context.TODO(), bson.M{"uid": msg.UID}).Decode(&deck); err != nil {
-				return
-			}
-
-			p.Player.CreateDeck(deck.Cards)
-
-			m.Chat("Server", fmt.Sprintf("%s has chosen their deck", s.User.Username))
-
-			p.Player.Ready = true
-
-			if m.Player1.Player.Ready && m.Player2.Player.Ready {
-				m.Start()
-			}
+			m.ChooseDeck(p, msg.UID)
 
 		}
 
@@ -1126,7 +1384,8 @@ func (m *Match) Parse(s *server.Socket, data []byte) {
 				return
 			}
 
-			if m.Turn != p.Player.Turn {
+			if err := m.ValidateTurnAction(p.Player, message.Header); err != nil {
+				Warn(p, err.Error())
 				return
 			}
 
@@ -1151,7 +1410,8 @@ func (m *Match) Parse(s *server.Socket, data []byte) {
 				return
 			}
 
-			if m.Turn != p.Player.Turn {
+			if err := m.ValidateTurnAction(p.Player, message.Header); err != nil {
+				Warn(p, err.Error())
 				return
 			}
 
@@ -1168,7 +1428,8 @@ func (m *Match) Parse(s *server.Socket, data []byte) {
 				return
 			}
 
-			if m.Turn != p.Player.Turn {
+			if err := m.ValidateTurnAction(p.Player, message.Header); err != nil {
+				Warn(p, err.Error())
 				return
 			}
 
@@ -1227,7 +1488,8 @@ func (m *Match) Parse(s *server.Socket, data []byte) {
 				return
 			}
 
-			if m.Turn != p.Player.Turn {
+			if err := m.ValidateTurnAction(p.Player, message.Header); err != nil {
+				Warn(p, err.Error())
 				return
 			}
 
@@ -1243,6 +1505,70 @@ func (m *Match) Parse(s *server.Socket, data []byte) {
 
 		}
 
+	case "concede":
+		{
+
+			p, err := m.PlayerForSocket(s)
+
+			if err != nil {
+				return
+			}
+
+			if !m.Started || m.ending {
+				Warn(p, "You can only concede while the duel is in progress")
+				return
+			}
+
+			m.Concede(p.Player)
+
+		}
+
+	case "rematch":
+		{
+
+			p, err := m.PlayerForSocket(s)
+
+			if err != nil {
+				return
+			}
+
+			if !m.Started || !m.ending {
+				return
+			}
+
+			var msg struct {
+				Accept bool `json:"accept"`
+			}
+
+			if err := json.Unmarshal(data, &msg); err != nil {
+				return
+			}
+
+			m.AnswerRematch(p, msg.Accept)
+
+		}
+
+	case "choose_first":
+		{
+
+			p, err := m.PlayerForSocket(s)
+
+			if err != nil {
+				return
+			}
+
+			var msg struct {
+				First bool `json:"first"`
+			}
+
+			if err := json.Unmarshal(data, &msg); err != nil {
+				return
+			}
+
+			m.ChooseFirst(p, msg.First)
+
+		}
+
 	case "attack_creature":
 		{
 
@@ -1252,7 +1578,8 @@ func (m *Match) Parse(s *server.Socket, data []byte) {
 				return
 			}
 
-			if m.Turn != p.Player.Turn {
+			if err := m.ValidateTurnAction(p.Player, message.Header); err != nil {
+				Warn(p, err.Error())
 				return
 			}
 
@@ -1282,7 +1609,7 @@ func (m *Match) OnSocketClose(s *server.Socket) {
 
 	// End if someone disconnects and there's no players in the match
 	if m.Player1 == nil && m.Player2 == nil {
-		m.quit <- true
+		m.stop()
 		return
 	}
 
@@ -1293,10 +1620,12 @@ func (m *Match) OnSocketClose(s *server.Socket) {
 
 			// Let player2 know if they are present and this was not during the end of the game
 			if m.Player2 != nil && !m.ending {
-				WarnError(m.Player2, "Your opponent disconnected, the match will close soon.")
+				m.disconnected(m.Player1, m.Player2)
 			}
 
-			m.quit <- true
+			m.leaveSet(m.Player1)
+
+			m.stop()
 		}
 	}
 
@@ -1307,11 +1636,25 @@ func (m *Match) OnSocketClose(s *server.Socket) {
 
 			// Let player1 know if they are present and this was not during the end of the game
 			if m.Player1 != nil && !m.ending {
-				WarnError(m.Player1, "Your opponent disconnected, the match will close soon.")
+				m.disconnected(m.Player2, m.Player1)
 			}
 
-			m.quit <- true
+			m.leaveSet(m.Player2)
+
+			m.stop()
 		}
 	}
 
 }
+
+// disconnected lets the remaining player know that their opponent left, forfeiting the duel if it was in progress
+func (m *Match) disconnected(left *PlayerReference, remaining *PlayerReference) {
+
+	if !m.Started {
+		WarnError(remaining, "Your opponent disconnected, the match will close soon.")
+		return
+	}
+
+	m.end(remaining.Player, fmt.Sprintf("%s disconnected, %s won the game", left.Socket.User.Username, remaining.Socket.User.Username), EndReasonDisconnect)
+
+}
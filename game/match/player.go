@@ -1,6 +1,8 @@
 package match
 
 import (
+	"duel-masters/db"
+	"duel-masters/game/cnd"
 	"duel-masters/server"
 	"errors"
 	"fmt"
@@ -25,24 +27,37 @@ const (
 
 // PlayerReference ties a player to a websocket connection
 type PlayerReference struct {
-	Player   *Player
-	Socket   *server.Socket
-	LastPong int64
+	Player     *Player
+	Socket     *server.Socket
+	LastPong   int64
+	LastActive int64
+	Rematch    bool
+
+	prompted     bool
+	idleWarned   bool
+	pingSent     int64 // when the oldest unanswered mping was sent, 0 if every mping was answered
+	rating       int
+	ratingChange int
 }
 
-// PlayerAction is the parsed response we retrieve after prompting the client for a selection of cards
+// PlayerAction is the parsed response we retrieve after prompting the client for a selection of cards,
+// a yes/no confirmation, a mode or a number
 type PlayerAction struct {
-	Cards  []string `json:"cards"`
-	Cancel bool     `json:"cancel"`
+	Cards   []string `json:"cards"`
+	Cancel  bool     `json:"cancel"`
+	Confirm bool     `json:"confirm"`
+	Mode    int      `json:"mode"`
+	Number  int      `json:"number"`
 }
 
 // NewPlayerReference returns a new player reference
 func NewPlayerReference(p *Player, s *server.Socket) *PlayerReference {
 
 	pr := &PlayerReference{
-		Player:   p,
-		Socket:   s,
-		LastPong: time.Now().Unix(),
+		Player:     p,
+		Socket:     s,
+		LastPong:   time.Now().Unix(),
+		LastActive: time.Now().Unix(),
 	}
 
 	return pr
@@ -65,9 +80,10 @@ type Player struct {
 	Action chan PlayerAction
 
 	HasChargedMana bool
-	CanChargeMana  bool
 	Turn           byte
 	Ready          bool
+	ChosenDeck     db.Deck
+	Stats          Stats
 
 	match *Match
 }
@@ -87,7 +103,6 @@ func NewPlayer(match *Match, turn byte) *Player {
 		mutex:          &sync.Mutex{},
 		Action:         make(chan PlayerAction),
 		HasChargedMana: false,
-		CanChargeMana:  true,
 		Turn:           turn,
 		Ready:          false,
 		match:          match,
@@ -218,10 +233,10 @@ func (p *Player) ShuffleDeck() {
 
 }
 
-// InitShieldzone adds 5 cards from the players deck to their shieldzone
-func (p *Player) InitShieldzone() {
+// InitShieldzone adds n cards from the players deck to their shieldzone
+func (p *Player) InitShieldzone(n int) {
 
-	cards := p.PeekDeck(5)
+	cards := p.PeekDeck(n)
 
 	for _, card := range cards {
 
@@ -329,6 +344,21 @@ func (p *Player) GetCard(id string, container string) (*Card, error) {
 
 // MoveCard tries to move a card from container a to container b
 func (p *Player) MoveCard(cardID string, from string, to string) (*Card, error) {
+	return p.moveCard(cardID, from, to, false)
+}
+
+// MoveCardToTop moves a card to the top of the container, the top of the deck is the next card to be drawn
+func (p *Player) MoveCardToTop(cardID string, from string, to string) (*Card, error) {
+	return p.moveCard(cardID, from, to, true)
+}
+
+// MoveCardToBottom moves a card to the bottom of the container, which is where MoveCard puts it as well
+func (p *Player) MoveCardToBottom(cardID string, from string, to string) (*Card, error) {
+	return p.moveCard(cardID, from, to, false)
+}
+
+// moveCard moves a card from container a to the top or the bottom of container b
+func (p *Player) moveCard(cardID string, from string, to string, top bool) (*Card, error) {
 
 	cFrom, err := p.ContainerRef(from)
 
@@ -361,18 +391,25 @@ func (p *Player) MoveCard(cardID string, from string, to string) (*Card, error)
 
 	*cFrom = temp
 
-	temp2 := append(*cTo, ref)
-
-	*cTo = temp2
+	if top {
+		*cTo = append([]*Card{ref}, *cTo...)
+	} else {
+		*cTo = append(*cTo, ref)
+	}
 
 	ref.Zone = to
 
+	if from == HAND && to == BATTLEZONE {
+		p.Stats.CardsPlayed++
+	}
+
 	p.mutex.Unlock()
 
 	p.match.HandleFx(NewContext(p.match, &CardMoved{
 		CardID: ref.ID,
 		From:   from,
 		To:     to,
+		ToTop:  top,
 	}))
 
 	return ref, nil
@@ -418,6 +455,7 @@ func (p *Player) Denormalized() *server.PlayerState {
 
 	state := &server.PlayerState{
 		Deck:       len(p.deck),
+		HandCount:  len(p.hand),
 		Hand:       denormalizeCards(p.hand, false),
 		Shieldzone: shields,
 		Manazone:   denormalizeCards(p.manazone, false),
@@ -425,8 +463,16 @@ func (p *Player) Denormalized() *server.PlayerState {
 		Battlezone: denormalizeCards(p.battlezone, false),
 	}
 
+	battlezone := make([]*Card, len(p.battlezone))
+	copy(battlezone, p.battlezone)
+
 	p.mutex.Unlock()
 
+	// The current power is resolved after unlocking as it passes a GetPowerEvent to every card
+	for i, card := range battlezone {
+		state.Battlezone[i].Power = p.match.GetPower(card, false)
+	}
+
 	return state
 
 }
@@ -448,12 +494,17 @@ func denormalizeCards(cards []*Card, partial bool) []server.CardState {
 		}
 
 		cs := server.CardState{
-			CardID:      card.ID,
-			ImageID:     card.ImageID,
-			Name:        card.Name,
-			Civ:         card.Civ,
-			Tapped:      card.Tapped,
-			CanBePlayed: canBePlayed,
+			CardID:            card.ID,
+			ImageID:           card.ImageID,
+			Name:              card.Name,
+			Civ:               card.Civ,
+			Tapped:            card.Tapped,
+			CanBePlayed:       canBePlayed,
+			Power:             card.Power,
+			SummoningSickness: card.HasCondition(cnd.SummoningSickness),
+			Conditions:        visibleConditions(card),
+			Text:              RulesText(card.ImageID),
+			Attachments:       denormalizeCards(card.Attachments(), partial),
 		}
 
 		if partial {
@@ -462,6 +513,10 @@ func denormalizeCards(cards []*Card, partial bool) []server.CardState {
 			cs.Civ = "water" // blue highlight color when selected in actions
 			cs.Tapped = false
 			cs.CanBePlayed = false
+			cs.Power = 0
+			cs.SummoningSickness = false
+			cs.Conditions = make([]string, 0)
+			cs.Text = make([]string, 0)
 		}
 
 		arr = append(arr, cs)
@@ -471,6 +526,36 @@ func denormalizeCards(cards []*Card, partial bool) []server.CardState {
 
 }
 
+// visibleConditions returns the unique conditions of a card that are of interest to the players
+func visibleConditions(card *Card) []string {
+
+	result := make([]string, 0)
+
+	for _, condition := range card.Conditions() {
+
+		switch condition.id {
+		case cnd.Creature, cnd.Spell, cnd.Active, cnd.SummoningSickness:
+			continue
+		}
+
+		duplicate := false
+
+		for _, c := range result {
+			if c == condition.id {
+				duplicate = true
+			}
+		}
+
+		if !duplicate {
+			result = append(result, condition.id)
+		}
+
+	}
+
+	return result
+
+}
+
 // Username returns the username of the player
 func (p *Player) Username() string {
 	return p.match.PlayerRef(p).Socket.User.Username
@@ -483,6 +568,7 @@ func (p *Player) Dispose() {
 
 	defer p.mutex.Unlock()
 
+	// Handlers that are still waiting for a selection stop once the channel is closed
 	close(p.Action)
 
 	for _, c := range p.deck {
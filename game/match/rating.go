@@ -0,0 +1,95 @@
+package match
+
+import (
+	"context"
+	"duel-masters/db"
+	"duel-masters/server"
+	"math"
+
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// Players with fewer ranked games than provisionalGames have their rating adjusted faster
+const (
+	provisionalGames = 30
+	provisionalK     = 40
+	establishedK     = 20
+)
+
+// Elo returns the new ratings of the winner and the loser of a game
+func Elo(winnerRating int, loserRating int, winnerGames int, loserGames int) (int, int) {
+
+	expected := 1 / (1 + math.Pow(10, float64(loserRating-winnerRating)/400))
+
+	winnerK := float64(establishedK)
+	if winnerGames < provisionalGames {
+		winnerK = provisionalK
+	}
+
+	loserK := float64(establishedK)
+	if loserGames < provisionalGames {
+		loserK = provisionalK
+	}
+
+	newWinnerRating := winnerRating + int(math.Round(winnerK*(1-expected)))
+	newLoserRating := loserRating - int(math.Round(loserK*(1-expected)))
+
+	return newWinnerRating, newLoserRating
+
+}
+
+// updateRatings stores the new ratings of both players after a ranked match has been decided
+func (m *Match) updateRatings() {
+
+	if !m.Ranked || m.winner == nil {
+		return
+	}
+
+	winner := m.PlayerRef(m.winner)
+	loser := m.PlayerRef(m.Opponent(m.winner))
+
+	collection := db.Collection("users")
+
+	var winnerUser db.User
+	if err := collection.FindOne(context.TODO(), bson.M{"uid": winner.Socket.User.UID}).Decode(&winnerUser); err != nil {
+		logrus.Error(err)
+		return
+	}
+
+	var loserUser db.User
+	if err := collection.FindOne(context.TODO(), bson.M{"uid": loser.Socket.User.UID}).Decode(&loserUser); err != nil {
+		logrus.Error(err)
+		return
+	}
+
+	winnerRating, loserRating := Elo(winnerUser.CurrentRating(), loserUser.CurrentRating(), winnerUser.RankedGames, loserUser.RankedGames)
+
+	winner.rating = winnerRating
+	winner.ratingChange = winnerRating - winnerUser.CurrentRating()
+	loser.rating = loserRating
+	loser.ratingChange = loserRating - loserUser.CurrentRating()
+
+	for _, p := range []*PlayerReference{winner, loser} {
+
+		if _, err := collection.UpdateOne(
+			context.TODO(),
+			bson.M{"uid": p.Socket.User.UID},
+			bson.M{"$set": bson.M{"rating": p.rating}, "$inc": bson.M{"rankedgames": 1}},
+		); err != nil {
+			logrus.Error(err)
+		}
+
+		// Keep the users of open sockets up to date so the lobby shows the new rating
+		for _, s := range server.Sockets() {
+			if s.User.UID == p.Socket.User.UID {
+				s.User.Rating = p.rating
+				s.User.RankedGames++
+			}
+		}
+
+	}
+
+	logrus.Debugf("Updated ratings after match %s", m.ID)
+
+}
@@ -0,0 +1,61 @@
+package match
+
+// Rules decides how a match is set up and which limits apply while it is played
+type Rules struct {
+	Name              string `json:"name"`
+	Format            string `json:"format"`
+	StartingShields   int    `json:"startingShields"`
+	StartingHand      int    `json:"startingHand"`
+	SkipFirstDraw     bool   `json:"skipFirstDraw"`
+	BattlezoneLimit   int    `json:"battlezoneLimit"`   // 0 means there is no limit
+	InactivityTimeout int    `json:"inactivityTimeout"` // seconds, 0 means players are never forfeited
+}
+
+// RuleProfiles are the rule sets a match can be created with
+var RuleProfiles = map[string]Rules{
+	"standard": {
+		Name:              "standard",
+		Format:            "standard",
+		StartingShields:   5,
+		StartingHand:      5,
+		SkipFirstDraw:     true,
+		BattlezoneLimit:   0,
+		InactivityTimeout: 300,
+	},
+	"casual": {
+		Name:              "casual",
+		Format:            "standard",
+		StartingShields:   5,
+		StartingHand:      5,
+		SkipFirstDraw:     false,
+		BattlezoneLimit:   0,
+		InactivityTimeout: 600,
+	},
+	"quick": {
+		Name:              "quick",
+		Format:            "standard",
+		StartingShields:   3,
+		StartingHand:      5,
+		SkipFirstDraw:     true,
+		BattlezoneLimit:   5,
+		InactivityTimeout: 180,
+	},
+}
+
+// DefaultRules returns the rules that are used when nothing else is specified
+func DefaultRules() Rules {
+	return RuleProfiles["standard"]
+}
+
+// GetRules returns the rule profile with the given name, or false if it does not exist
+func GetRules(name string) (Rules, bool) {
+
+	if name == "" {
+		return DefaultRules(), true
+	}
+
+	rules, ok := RuleProfiles[name]
+
+	return rules, ok
+
+}
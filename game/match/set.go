@@ -0,0 +1,225 @@
+package match
+
+import (
+	"duel-masters/server"
+	"fmt"
+	"time"
+)
+
+// Seconds the loser of a game in a set has to choose who goes first in the next game
+const chooseFirstTimeout = 30
+
+// Set is a series of games between the same two users.
+// The first user to win the majority of the games wins the set
+type Set struct {
+	BestOf      int
+	SwitchDecks bool
+
+	wins      map[string]int
+	games     int
+	turns     int
+	startedAt int64
+}
+
+// NewSet returns a new set of up to bestOf games. If switchDecks is true
+// the players choose their deck again before each game, otherwise they keep it
+func NewSet(bestOf int, switchDecks bool) *Set {
+
+	return &Set{
+		BestOf:      bestOf,
+		SwitchDecks: switchDecks,
+		wins:        make(map[string]int),
+	}
+
+}
+
+// Wins returns the number of games the user has won in the set
+func (s *Set) Wins(uid string) int {
+	return s.wins[uid]
+}
+
+// Winner returns the uid of the user that has won the set, or an empty string if it is not decided yet
+func (s *Set) Winner() string {
+
+	for uid, wins := range s.wins {
+		if wins > s.BestOf/2 {
+			return uid
+		}
+	}
+
+	return ""
+
+}
+
+// endGame records the result of a game of the set. Once the set is decided the
+// result of the whole set is stored, otherwise the loser chooses who goes first in the next game
+func (m *Match) endGame(winnerStr string) {
+
+	s := m.Set
+
+	if s.games < 1 {
+		s.startedAt = m.startedAt
+	}
+
+	winner := m.PlayerRef(m.winner)
+	loser := m.PlayerRef(m.Opponent(m.winner))
+
+	s.games++
+	s.turns += m.TurnNumber
+	s.wins[winner.Socket.User.UID]++
+
+	// A player that left forfeits the whole set, as there is no one to play the next game with
+	if m.endReason == EndReasonDisconnect {
+		s.wins[winner.Socket.User.UID] = s.BestOf/2 + 1
+	}
+
+	decided := s.Winner() != ""
+
+	if decided {
+		m.updateRatings()
+		m.saveResult()
+
+		if m.OnEnd != nil {
+			go m.OnEnd(s.Winner())
+		}
+	}
+
+	summary := m.Summary(winnerStr)
+
+	m.Player1.Socket.Send(summary)
+	m.Player2.Socket.Send(summary)
+
+	if decided {
+		m.Chat("Server", fmt.Sprintf("%s won the set %d-%d", winner.Socket.User.Username, s.Wins(winner.Socket.User.UID), s.Wins(loser.Socket.User.UID)))
+		m.stop()
+		return
+	}
+
+	m.Chat("Server", fmt.Sprintf("The set is %s %d-%d %s", m.Player1.Socket.User.Username, s.Wins(m.Player1.Socket.User.UID), s.Wins(m.Player2.Socket.User.UID), m.Player2.Socket.User.Username))
+	m.Chat("Server", fmt.Sprintf("Waiting for %s to choose who goes first in the next game", loser.Socket.User.Username))
+
+	loser.Socket.Send(server.ChooseFirstMessage{
+		Header:  "choose_first",
+		Timeout: chooseFirstTimeout,
+	})
+
+	go m.awaitNextGame(loser)
+
+}
+
+// ChooseFirst registers the choice of the loser of the previous game whether they want to go first
+func (m *Match) ChooseFirst(p *PlayerReference, first bool) {
+
+	if m.Set == nil || !m.ending || m.winner == nil || p.Player == m.winner {
+		return
+	}
+
+	select {
+	case m.first <- first:
+	default:
+	}
+
+}
+
+// awaitNextGame waits for the loser of the game to choose who goes first and
+// creates the next game of the set. If the loser does not answer in time they go first
+func (m *Match) awaitNextGame(loser *PlayerReference) {
+
+	defer m.stop()
+
+	first := loser
+	winner := m.PlayerRef(m.winner)
+
+	select {
+	case loserFirst := <-m.first:
+		{
+			if !loserFirst {
+				first = winner
+			}
+		}
+	case p := <-m.left:
+		{
+			m.forfeitSet(p)
+			return
+		}
+	case <-time.After(chooseFirstTimeout * time.Second):
+	}
+
+	for _, p := range []*PlayerReference{m.Player1, m.Player2} {
+		if !p.Socket.Connected() {
+			m.forfeitSet(p)
+			return
+		}
+	}
+
+	next := New(m.MatchName, m.HostID, false, m.Rules)
+	next.GuestID = m.Player2.Socket.User.UID
+	// The next game continues the set the players joined publicly. A ranked set
+	// only updates the ratings once, when the whole set has been decided
+	next.Ranked = m.Ranked
+	next.Tournament = m.Tournament
+	next.OnEnd = m.OnEnd
+	next.Set = m.Set
+	next.FirstPlayer = first.Socket.User.UID
+
+	if !m.Set.SwitchDecks {
+		for _, p := range []*PlayerReference{m.Player1, m.Player2} {
+			next.PresetDecks[p.Socket.User.UID] = p.Player.ChosenDeck.UID
+		}
+	}
+
+	msg := server.NextGameMessage{
+		Header: "next_game",
+		ID:     next.ID,
+		Game:   m.Set.games + 1,
+	}
+
+	m.Player1.Socket.Send(msg)
+	m.Player2.Socket.Send(msg)
+
+}
+
+// leaveSet lets the match know that the player left while waiting for the next game of the set
+func (m *Match) leaveSet(p *PlayerReference) {
+
+	if m.Set == nil || !m.ending || m.Set.Winner() != "" {
+		return
+	}
+
+	select {
+	case m.left <- p:
+	default:
+	}
+
+}
+
+// forfeitSet ends a set the player left before it was decided, their opponent wins the set
+func (m *Match) forfeitSet(left *PlayerReference) {
+
+	remaining := m.PlayerRef(m.Opponent(left.Player))
+
+	m.winner = remaining.Player
+	m.endReason = EndReasonDisconnect
+	m.Set.wins[remaining.Socket.User.UID] = m.Set.BestOf/2 + 1
+
+	m.updateRatings()
+	m.saveResult()
+
+	if m.OnEnd != nil {
+		go m.OnEnd(m.Set.Winner())
+	}
+
+	m.Chat("Server", fmt.Sprintf("%s left, %s won the set", left.Socket.User.Username, remaining.Socket.User.Username))
+
+}
+
+// setWins returns the number of games the player has won in the match's set
+func (m *Match) setWins(p *PlayerReference) int {
+
+	if m.Set == nil {
+		return 0
+	}
+
+	return m.Set.Wins(p.Socket.User.UID)
+
+}
@@ -0,0 +1,109 @@
+package match
+
+import (
+	"errors"
+	"fmt"
+)
+
+// AddShield puts the card face down into the player's shieldzone, no matter which of their zones it is in
+func (p *Player) AddShield(cardID string, from string) (*Card, error) {
+
+	if from == SHIELDZONE {
+		return nil, errors.New("Card is already a shield")
+	}
+
+	card, err := p.MoveCard(cardID, from, SHIELDZONE)
+
+	if err != nil {
+		return nil, err
+	}
+
+	card.Tapped = false
+
+	p.match.HandleFx(NewContext(p.match, &ShieldAdded{CardID: card.ID, From: from}))
+
+	return card, nil
+
+}
+
+// ReturnShieldToHand puts the shield into the player's hand without breaking it,
+// so neither ShieldBroken is fired nor can its shield trigger be used
+func (p *Player) ReturnShieldToHand(cardID string) (*Card, error) {
+	return p.MoveCard(cardID, SHIELDZONE, HAND)
+}
+
+// ChooseShields prompts the user to select n of the owner's shields without seeing what they are
+func ChooseShields(p *Player, m *Match, owner *Player, text string, min int, max int, cancellable bool) []*Card {
+
+	result := make([]*Card, 0)
+
+	shields, err := owner.Container(SHIELDZONE)
+
+	if err != nil || len(shields) < 1 {
+		return result
+	}
+
+	if max > len(shields) {
+		max = len(shields)
+	}
+
+	if min > max {
+		min = max
+	}
+
+	m.NewBacksideAction(p, shields, min, max, text, cancellable)
+
+	defer m.CloseAction(p)
+
+	for {
+
+		action, ok := <-p.Action
+
+		if !ok {
+			return result
+		}
+
+		if cancellable && action.Cancel {
+			break
+		}
+
+		if len(action.Cards) < min || len(action.Cards) > max || !AssertCardsIn(shields, action.Cards...) {
+			m.DefaultActionWarning(p)
+			continue
+		}
+
+		for _, id := range action.Cards {
+
+			shield, err := owner.GetCard(id, SHIELDZONE)
+
+			if err != nil {
+				continue
+			}
+
+			result = append(result, shield)
+
+		}
+
+		break
+
+	}
+
+	return result
+
+}
+
+// PeekShield prompts the user to choose one of the owner's shields and shows it to them, the shield stays face down
+func PeekShield(p *Player, m *Match, owner *Player, text string) *Card {
+
+	shields := ChooseShields(p, m, owner, text, 1, 1, false)
+
+	if len(shields) < 1 {
+		return nil
+	}
+
+	m.ShowCards(p, "The shield you looked at", shields)
+	m.Chat("Server", fmt.Sprintf("%s looked at one of %s's shields", p.Username(), owner.Username()))
+
+	return shields[0]
+
+}
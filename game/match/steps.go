@@ -1,5 +1,10 @@
 package match
 
+import (
+	"errors"
+	"fmt"
+)
+
 // BeginTurnStep ...
 // Resolve any summoning sickness from creatures in the battle zone.
 type BeginTurnStep struct{}
@@ -36,3 +41,75 @@ type EndStep struct{}
 // EndOfTurnStep ...
 // Any abilities that trigger at "the end of your turn" are resolved now.
 type EndOfTurnStep struct{}
+
+// Phase is the name of the step the match is currently in
+type Phase string
+
+// Turn phases, in the order they are played
+const (
+	PhaseIdle        Phase = "idle"
+	PhaseBeginTurn   Phase = "begin_turn"
+	PhaseUntap       Phase = "untap"
+	PhaseStartOfTurn Phase = "start_of_turn"
+	PhaseDraw        Phase = "draw"
+	PhaseCharge      Phase = "charge"
+	PhaseMain        Phase = "main"
+	PhaseAttack      Phase = "attack"
+	PhaseEnd         Phase = "end"
+)
+
+// phaseNames are used to describe the phases in warnings sent to the players
+var phaseNames = map[Phase]string{
+	PhaseIdle:        "before the duel",
+	PhaseBeginTurn:   "the beginning of the turn",
+	PhaseUntap:       "the untap step",
+	PhaseStartOfTurn: "the start of the turn",
+	PhaseDraw:        "the draw step",
+	PhaseCharge:      "the charge step",
+	PhaseMain:        "the main step",
+	PhaseAttack:      "the attack step",
+	PhaseEnd:         "the end of the turn",
+}
+
+// turnActions maps the messages a player can send on their own turn to the phases they are allowed in
+var turnActions = map[string][]Phase{
+	"add_to_manazone": {PhaseCharge},
+	"add_to_playzone": {PhaseCharge, PhaseMain},
+	"attack_player":   {PhaseCharge, PhaseMain, PhaseAttack},
+	"attack_creature": {PhaseCharge, PhaseMain, PhaseAttack},
+	"end_turn":        {PhaseCharge, PhaseMain, PhaseAttack},
+}
+
+// turnActionNames are used to describe the messages in turnActions in warnings sent to the players
+var turnActionNames = map[string]string{
+	"add_to_manazone": "charge mana",
+	"add_to_playzone": "play cards",
+	"attack_player":   "attack",
+	"attack_creature": "attack",
+	"end_turn":        "end your turn",
+}
+
+// ValidateTurnAction returns an error if the given player is not allowed to send the given message in the current phase
+func (m *Match) ValidateTurnAction(p *Player, header string) error {
+
+	if !m.Started {
+		return errors.New("The duel has not started yet")
+	}
+
+	if m.ending {
+		return errors.New("The duel is over")
+	}
+
+	if !m.IsPlayerTurn(p) {
+		return errors.New("It is not your turn")
+	}
+
+	for _, phase := range turnActions[header] {
+		if phase == m.Phase {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("You cannot %s during %s", turnActionNames[header], phaseNames[m.Phase])
+
+}
@@ -0,0 +1,211 @@
+package match
+
+import (
+	"duel-masters/server"
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Seconds the players have to accept a rematch after the match has ended
+const rematchTimeout = 30
+
+// Reasons for a match to end
+const (
+	EndReasonWin        = "win"
+	EndReasonConcede    = "concede"
+	EndReasonDisconnect = "disconnect"
+	EndReasonInactivity = "inactivity"
+)
+
+// Stats keeps track of what a player has done during the match
+type Stats struct {
+	ShieldsBroken      int
+	CreaturesDestroyed int
+	CardsPlayed        int
+}
+
+// Concede ends the match with the opponent of the given player as the winner
+func (m *Match) Concede(p *Player) {
+
+	opponent := m.Opponent(p)
+
+	m.end(opponent, fmt.Sprintf("%s conceded, %s won the game", p.Username(), opponent.Username()), EndReasonConcede)
+
+}
+
+// end ends the match, sends the summary to both players and offers them a rematch
+func (m *Match) end(winner *Player, winnerStr string, reason string) {
+
+	logrus.Debugf("Attempting to end match %s", m.ID)
+
+	if m.ending {
+		logrus.Debugf("Cannot end match, %s is already ending", m.ID)
+		return
+	}
+
+	m.ending = true
+
+	if !m.Started {
+		m.stop()
+		return
+	}
+
+	m.winner = winner
+	m.endReason = reason
+
+	WarnError(m.PlayerRef(winner), winnerStr)
+	WarnError(m.PlayerRef(m.Opponent(winner)), winnerStr)
+
+	if m.Set != nil {
+		m.endGame(winnerStr)
+		return
+	}
+
+	m.updateRatings()
+	m.saveResult()
+
+	if m.OnEnd != nil && winner != nil {
+		go m.OnEnd(m.PlayerRef(winner).Socket.User.UID)
+	}
+
+	summary := m.Summary(winnerStr)
+
+	m.Player1.Socket.Send(summary)
+	m.Player2.Socket.Send(summary)
+
+	// There's no one to offer a rematch to if a player left, and tournament matches are never replayed
+	if reason == EndReasonDisconnect || m.Tournament != "" {
+		m.stop()
+		return
+	}
+
+	offer := server.RematchOfferMessage{
+		Header:  "rematch_offer",
+		Timeout: rematchTimeout,
+	}
+
+	m.Player1.Socket.Send(offer)
+	m.Player2.Socket.Send(offer)
+
+	go m.awaitRematch()
+
+}
+
+// Summary returns the post-game summary of the match
+func (m *Match) Summary(message string) server.MatchSummaryMessage {
+
+	winner := ""
+
+	if m.winner != nil {
+		winner = m.winner.Username()
+	}
+
+	players := make([]server.PlayerSummary, 0)
+
+	for _, p := range []*PlayerReference{m.Player1, m.Player2} {
+		players = append(players, server.PlayerSummary{
+			Username:           p.Socket.User.Username,
+			ShieldsBroken:      p.Player.Stats.ShieldsBroken,
+			CreaturesDestroyed: p.Player.Stats.CreaturesDestroyed,
+			CardsPlayed:        p.Player.Stats.CardsPlayed,
+			Rating:             p.rating,
+			RatingChange:       p.ratingChange,
+			SetWins:            m.setWins(p),
+		})
+	}
+
+	summary := server.MatchSummaryMessage{
+		Header:  "summary",
+		Winner:  winner,
+		Reason:  m.endReason,
+		Message: message,
+		Turns:   m.TurnNumber,
+		Players: players,
+	}
+
+	if m.Set != nil {
+
+		summary.BestOf = m.Set.BestOf
+
+		for _, p := range []*PlayerReference{m.Player1, m.Player2} {
+			if p.Socket.User.UID == m.Set.Winner() {
+				summary.SetWinner = p.Socket.User.Username
+			}
+		}
+
+	}
+
+	return summary
+
+}
+
+// AnswerRematch registers a player's answer to the rematch offer
+func (m *Match) AnswerRematch(p *PlayerReference, accept bool) {
+
+	if !accept {
+		m.Chat("Server", fmt.Sprintf("%s declined the rematch", p.Socket.User.Username))
+		m.signalRematch(false)
+		return
+	}
+
+	p.Rematch = true
+
+	m.Chat("Server", fmt.Sprintf("%s wants a rematch", p.Socket.User.Username))
+
+	if m.Player1.Rematch && m.Player2.Rematch {
+		m.signalRematch(true)
+	}
+
+}
+
+// signalRematch passes the outcome of the rematch offer to awaitRematch without blocking
+func (m *Match) signalRematch(accepted bool) {
+	select {
+	case m.rematch <- accepted:
+	default:
+	}
+}
+
+// awaitRematch waits for both players to accept the rematch before closing the match.
+// If they do, a new match with both seats reserved is created and the players are sent to it
+func (m *Match) awaitRematch() {
+
+	defer m.stop()
+
+	select {
+	case accepted := <-m.rematch:
+		{
+			if !accepted {
+				return
+			}
+		}
+	case <-time.After(rematchTimeout * time.Second):
+		{
+			return
+		}
+	}
+
+	rematch := New(m.MatchName, m.Player1.Socket.User.UID, false, m.Rules)
+	rematch.GuestID = m.Player2.Socket.User.UID
+
+	// Rematches are private and never ranked, so rating cannot be traded by rematching the same opponent
+	rematch.Ranked = false
+
+	msg := server.RematchMessage{
+		Header: "rematch",
+		ID:     rematch.ID,
+	}
+
+	m.Player1.Socket.Send(msg)
+	m.Player2.Socket.Send(msg)
+
+}
+
+// stop signals the match ticker to dispose the match. It never blocks and can safely be called multiple times
+func (m *Match) stop() {
+	m.quitOnce.Do(func() {
+		m.quit <- true
+	})
+}
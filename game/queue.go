@@ -0,0 +1,311 @@
+package game
+
+import (
+	"context"
+	"duel-masters/db"
+	"duel-masters/game/format"
+	"duel-masters/game/match"
+	"duel-masters/server"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// Queue modes
+const (
+	QueueCasual = "casual"
+	QueueRanked = "ranked"
+)
+
+const (
+	// Rating difference allowed between two ranked players as soon as they join the queue
+	baseRatingWindow = 100
+	// The rating window grows by this amount for every 10 seconds a player has waited
+	ratingWindowGrowth = 50
+	maxRatingWindow    = 1000
+)
+
+// queueEntry is a player waiting to be paired in the matchmaking queue
+type queueEntry struct {
+	socket *server.Socket
+	deck   string
+	mode   string
+	rating int
+	joined time.Time
+}
+
+// ratingWindow returns the largest rating difference the entry accepts for an opponent
+func (e *queueEntry) ratingWindow(now time.Time) int {
+
+	window := baseRatingWindow + int(now.Sub(e.joined)/(10*time.Second))*ratingWindowGrowth
+
+	if window > maxRatingWindow {
+		window = maxRatingWindow
+	}
+
+	return window
+
+}
+
+// compatible returns true if the two entries can be paired with each other
+func (e *queueEntry) compatible(other *queueEntry, now time.Time) bool {
+
+	if e.mode != other.mode || e.socket.User.UID == other.socket.User.UID {
+		return false
+	}
+
+	if e.mode != QueueRanked {
+		return true
+	}
+
+	difference := e.rating - other.rating
+	if difference < 0 {
+		difference = -difference
+	}
+
+	return difference <= e.ratingWindow(now) && difference <= other.ratingWindow(now)
+
+}
+
+var queue = &Queue{}
+
+var queueEntries = make([]*queueEntry, 0)
+var queueMutex = &sync.Mutex{}
+
+// Queue struct is used to create a Hub that pairs players looking for a match
+type Queue struct{}
+
+// Name just returns "queue", obligatory for a hub
+func (q *Queue) Name() string {
+	return "queue"
+}
+
+// GetQueue returns a reference to the matchmaking queue
+func GetQueue() *Queue {
+	return queue
+}
+
+// StartTicker starts the queue ticker that pairs the waiting players
+func (q *Queue) StartTicker() {
+
+	ticker := time.NewTicker(2 * time.Second) // tick every 2 seconds
+
+	defer ticker.Stop()
+
+	defer func() {
+		if r := recover(); r != nil {
+			logrus.Errorf("Recovered from queue ticker. %v", r)
+		}
+	}()
+
+	for {
+
+		select {
+		case <-ticker.C:
+			{
+				pairQueue()
+			}
+		}
+
+	}
+
+}
+
+// pairQueue creates matches for all compatible players in the queue, longest waiting players first
+func pairQueue() {
+
+	queueMutex.Lock()
+	defer queueMutex.Unlock()
+
+	now := time.Now()
+
+	paired := make(map[*queueEntry]bool)
+
+	for i, entry := range queueEntries {
+
+		if paired[entry] {
+			continue
+		}
+
+		for _, other := range queueEntries[i+1:] {
+
+			if paired[other] || !entry.compatible(other, now) {
+				continue
+			}
+
+			paired[entry] = true
+			paired[other] = true
+
+			createQueueMatch(entry, other)
+
+			break
+
+		}
+
+	}
+
+	remaining := make([]*queueEntry, 0)
+
+	for _, entry := range queueEntries {
+		if !paired[entry] {
+			remaining = append(remaining, entry)
+		}
+	}
+
+	queueEntries = remaining
+
+}
+
+// createQueueMatch creates a match with both players and their decks pre-assigned and sends them to it
+func createQueueMatch(host *queueEntry, guest *queueEntry) {
+
+	name := "Casual match"
+	if host.mode == QueueRanked {
+		name = "Ranked match"
+	}
+
+	m := match.New(name, host.socket.User.UID, false, match.DefaultRules())
+	m.GuestID = guest.socket.User.UID
+	m.Ranked = host.mode == QueueRanked
+	m.PresetDecks[host.socket.User.UID] = host.deck
+	m.PresetDecks[guest.socket.User.UID] = guest.deck
+
+	logrus.Debugf("Paired %s and %s in match %s", host.socket.User.Username, guest.socket.User.Username, m.ID)
+
+	msg := server.MatchFoundMessage{
+		Header: "match_found",
+		ID:     m.ID,
+	}
+
+	host.socket.Send(msg)
+	guest.socket.Send(msg)
+
+}
+
+// Parse websocket messages
+func (q *Queue) Parse(s *server.Socket, data []byte) {
+
+	defer func() {
+		if r := recover(); r != nil {
+			logrus.Warnf("Recovered from parsing a message in queue. %v", r)
+		}
+	}()
+
+	var message server.Message
+	if err := json.Unmarshal(data, &message); err != nil {
+		return
+	}
+
+	switch message.Header {
+
+	case "join_queue":
+		{
+
+			var msg struct {
+				Deck string `json:"deck"`
+				Mode string `json:"mode"`
+			}
+
+			if err := json.Unmarshal(data, &msg); err != nil {
+				return
+			}
+
+			if msg.Mode != QueueCasual && msg.Mode != QueueRanked {
+				s.Send(server.WarningMessage{
+					Header:  "error",
+					Message: "Invalid queue mode",
+				})
+				return
+			}
+
+			var deck db.Deck
+
+			if err := db.Collection("decks").FindOne(context.TODO(), bson.M{
+				"uid": msg.Deck,
+				"$or": []bson.M{
+					{"owner": s.User.UID},
+					{"standard": true},
+				},
+			}).Decode(&deck); err != nil {
+				s.Send(server.WarningMessage{
+					Header:  "error",
+					Message: "The selected deck does not exist",
+				})
+				return
+			}
+
+			f, _ := format.Get(match.DefaultRules().Format)
+
+			if violations := f.Validate(deck.Cards); len(violations) > 0 {
+				s.Send(server.WarningMessage{
+					Header:  "error",
+					Message: fmt.Sprintf("%s is not legal in the %s format: %s", deck.Name, f.Name, violations[0].Message),
+				})
+				return
+			}
+
+			queueMutex.Lock()
+			defer queueMutex.Unlock()
+
+			for _, entry := range queueEntries {
+				if entry.socket.User.UID == s.User.UID {
+					s.Send(server.WarningMessage{
+						Header:  "error",
+						Message: "You are already in the queue",
+					})
+					return
+				}
+			}
+
+			queueEntries = append(queueEntries, &queueEntry{
+				socket: s,
+				deck:   deck.UID,
+				mode:   msg.Mode,
+				rating: s.User.CurrentRating(),
+				joined: time.Now(),
+			})
+
+			s.Send(server.QueueMessage{
+				Header:  "queued",
+				Mode:    msg.Mode,
+				Message: fmt.Sprintf("Looking for a %s match with %s", msg.Mode, deck.Name),
+			})
+
+		}
+
+	case "leave_queue":
+		{
+			removeFromQueue(s)
+			s.Send(server.Message{Header: "left_queue"})
+		}
+
+	}
+
+}
+
+// removeFromQueue removes the socket's entry from the queue if it has one
+func removeFromQueue(s *server.Socket) {
+
+	queueMutex.Lock()
+	defer queueMutex.Unlock()
+
+	remaining := make([]*queueEntry, 0)
+
+	for _, entry := range queueEntries {
+		if entry.socket != s {
+			remaining = append(remaining, entry)
+		}
+	}
+
+	queueEntries = remaining
+
+}
+
+// OnSocketClose is called when a socket disconnects
+func (q *Queue) OnSocketClose(s *server.Socket) {
+	removeFromQueue(s)
+}
@@ -0,0 +1,97 @@
+package tournament
+
+import "duel-masters/db"
+
+// bracketOrder returns the seeds in the order they are placed in a single-elimination
+// bracket of the given size, so that the highest seeds can only meet in the later rounds
+func bracketOrder(size int) []int {
+
+	order := []int{1}
+
+	for len(order) < size {
+
+		next := make([]int, 0, len(order)*2)
+		sum := len(order)*2 + 1
+
+		for _, seed := range order {
+			next = append(next, seed, sum-seed)
+		}
+
+		order = next
+
+	}
+
+	return order
+
+}
+
+// eliminationPairings pairs the seeded players for the first round of a single-elimination
+// bracket. Missing players in brackets that are not a power of two are replaced with byes
+func eliminationPairings(seeds []string) []db.TournamentPairing {
+
+	size := 1
+	for size < len(seeds) {
+		size *= 2
+	}
+
+	order := bracketOrder(size)
+	pairings := make([]db.TournamentPairing, 0)
+
+	for i := 0; i+1 < len(order); i += 2 {
+
+		// The higher seed is always first and never a bye
+		pairing := db.TournamentPairing{Player1: seeds[order[i]-1]}
+
+		if order[i+1] <= len(seeds) {
+			pairing.Player2 = seeds[order[i+1]-1]
+		} else {
+			pairing.Winner = pairing.Player1
+		}
+
+		pairings = append(pairings, pairing)
+
+	}
+
+	return pairings
+
+}
+
+// nextEliminationPairings pairs the winners of adjacent pairings of the previous bracket round.
+// A winner that has dropped since gives their opponent a bye, if both have dropped the higher seed advances
+func nextEliminationPairings(t *db.Tournament, previous []db.TournamentPairing) []db.TournamentPairing {
+
+	pairings := make([]db.TournamentPairing, 0)
+
+	for i := 0; i+1 < len(previous); i += 2 {
+
+		player1 := previous[i].Winner
+		player2 := previous[i+1].Winner
+
+		if hasDropped(t, player1) && !hasDropped(t, player2) {
+			player1, player2 = player2, player1
+		}
+
+		pairing := db.TournamentPairing{Player1: player1}
+
+		if hasDropped(t, player2) {
+			pairing.Winner = player1
+		} else {
+			pairing.Player2 = player2
+		}
+
+		pairings = append(pairings, pairing)
+
+	}
+
+	return pairings
+
+}
+
+// hasDropped returns true if the player has dropped from the tournament
+func hasDropped(t *db.Tournament, uid string) bool {
+
+	p := player(t, uid)
+
+	return p != nil && p.Dropped
+
+}
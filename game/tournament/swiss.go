@@ -0,0 +1,299 @@
+package tournament
+
+import (
+	"duel-masters/db"
+	"sort"
+)
+
+// Points awarded for a won swiss round, byes count as a win
+const winPoints = 3
+
+// Matches won by opponents are counted as at least a third when calculating tiebreakers
+const minimumWinPercentage = 1.0 / 3.0
+
+// Pairings that are tried while avoiding rematches before rematches are allowed, as the search
+// runs while every tournament is locked and grows exponentially with the number of players
+const maxPairingAttempts = 10000
+
+// Standing is a player's record in the swiss rounds of a tournament
+type Standing struct {
+	UID      string  `json:"uid"`
+	Username string  `json:"username"`
+	Points   int     `json:"points"`
+	Wins     int     `json:"wins"`
+	Losses   int     `json:"losses"`
+	Byes     int     `json:"byes"`
+	OMW      float64 `json:"opponentsMatchWin"`
+	OOMW     float64 `json:"opponentsOpponentsMatchWin"`
+	Dropped  bool    `json:"dropped"`
+
+	opponents []string
+	seed      int
+}
+
+// matchWin returns the player's match win percentage, byes are not counted
+func (s *Standing) matchWin() float64 {
+
+	played := s.Wins + s.Losses - s.Byes
+
+	if played < 1 {
+		return minimumWinPercentage
+	}
+
+	result := float64(s.Wins-s.Byes) / float64(played)
+
+	if result < minimumWinPercentage {
+		return minimumWinPercentage
+	}
+
+	return result
+
+}
+
+// Standings returns the players of the tournament ordered by points, then by
+// their opponents' match win percentage and their opponents' opponents' match win percentage
+func Standings(t *db.Tournament) []*Standing {
+
+	standings := make(map[string]*Standing)
+	result := make([]*Standing, 0)
+
+	for i, p := range t.Players {
+
+		s := &Standing{
+			UID:       p.UID,
+			Username:  p.Username,
+			Dropped:   p.Dropped,
+			opponents: make([]string, 0),
+			seed:      i,
+		}
+
+		standings[p.UID] = s
+		result = append(result, s)
+
+	}
+
+	for _, round := range t.Rounds {
+
+		if round.Stage != StageSwiss {
+			continue
+		}
+
+		for _, pairing := range round.Pairings {
+
+			if pairing.Winner == "" {
+				continue
+			}
+
+			p1, ok1 := standings[pairing.Player1]
+			p2, ok2 := standings[pairing.Player2]
+
+			if pairing.Player2 == "" {
+				if ok1 {
+					p1.Wins++
+					p1.Byes++
+					p1.Points += winPoints
+				}
+				continue
+			}
+
+			if !ok1 || !ok2 {
+				continue
+			}
+
+			p1.opponents = append(p1.opponents, p2.UID)
+			p2.opponents = append(p2.opponents, p1.UID)
+
+			if pairing.Winner == p1.UID {
+				p1.Wins++
+				p1.Points += winPoints
+				p2.Losses++
+			} else {
+				p2.Wins++
+				p2.Points += winPoints
+				p1.Losses++
+			}
+
+		}
+
+	}
+
+	for _, s := range result {
+		s.OMW = averageOf(s.opponents, func(uid string) float64 { return standings[uid].matchWin() })
+	}
+
+	for _, s := range result {
+		s.OOMW = averageOf(s.opponents, func(uid string) float64 { return standings[uid].OMW })
+	}
+
+	sort.SliceStable(result, func(i int, j int) bool {
+
+		a, b := result[i], result[j]
+
+		if a.Points != b.Points {
+			return a.Points > b.Points
+		}
+
+		if a.OMW != b.OMW {
+			return a.OMW > b.OMW
+		}
+
+		if a.OOMW != b.OOMW {
+			return a.OOMW > b.OOMW
+		}
+
+		return a.seed < b.seed
+
+	})
+
+	return result
+
+}
+
+// averageOf returns the average of the values for the given opponents
+func averageOf(opponents []string, value func(uid string) float64) float64 {
+
+	if len(opponents) < 1 {
+		return 0
+	}
+
+	total := 0.0
+
+	for _, uid := range opponents {
+		total += value(uid)
+	}
+
+	return total / float64(len(opponents))
+
+}
+
+// swissPairings pairs the active players with opponents of a similar score they have not played yet.
+// If the number of players is odd the lowest ranked player without a bye receives one
+func swissPairings(t *db.Tournament) []db.TournamentPairing {
+
+	players := make([]*Standing, 0)
+
+	for _, s := range Standings(t) {
+		if !s.Dropped {
+			players = append(players, s)
+		}
+	}
+
+	pairings := make([]db.TournamentPairing, 0)
+
+	if len(players)%2 == 1 {
+
+		bye := len(players) - 1
+
+		for i := len(players) - 1; i >= 0; i-- {
+			if players[i].Byes < 1 {
+				bye = i
+				break
+			}
+		}
+
+		pairings = append(pairings, db.TournamentPairing{
+			Player1: players[bye].UID,
+			Winner:  players[bye].UID,
+		})
+
+		players = append(players[:bye], players[bye+1:]...)
+
+	}
+
+	attempts := maxPairingAttempts
+
+	paired, ok := pairWithoutRematches(players, &attempts)
+
+	if !ok {
+		// Every combination would lead to a rematch or there are too many to try, allow rematches instead
+		paired = pairGreedily(players)
+	}
+
+	return append(paired, pairings...)
+
+}
+
+// pairWithoutRematches pairs the highest ranked player with the next highest ranked
+// player they have not played yet, backtracking if the remaining players cannot be paired.
+// Every pairing that is tried uses up one of the attempts, it gives up once there are none left
+func pairWithoutRematches(players []*Standing, attempts *int) ([]db.TournamentPairing, bool) {
+
+	if len(players) < 2 {
+		return []db.TournamentPairing{}, true
+	}
+
+	first := players[0]
+
+	for i := 1; i < len(players); i++ {
+
+		opponent := players[i]
+
+		if hasPlayed(first, opponent.UID) {
+			continue
+		}
+
+		if *attempts < 1 {
+			return nil, false
+		}
+
+		*attempts--
+
+		remaining := make([]*Standing, 0, len(players)-2)
+		remaining = append(remaining, players[1:i]...)
+		remaining = append(remaining, players[i+1:]...)
+
+		rest, ok := pairWithoutRematches(remaining, attempts)
+
+		if !ok {
+			continue
+		}
+
+		return append([]db.TournamentPairing{{Player1: first.UID, Player2: opponent.UID}}, rest...), true
+
+	}
+
+	return nil, false
+
+}
+
+// pairGreedily pairs the highest ranked player with the next highest ranked player they have
+// not played yet, or with the next highest ranked player if they have played everyone left
+func pairGreedily(players []*Standing) []db.TournamentPairing {
+
+	pairings := make([]db.TournamentPairing, 0)
+	remaining := append([]*Standing{}, players...)
+
+	for len(remaining) >= 2 {
+
+		first := remaining[0]
+		opponent := 1
+
+		for i := 1; i < len(remaining); i++ {
+			if !hasPlayed(first, remaining[i].UID) {
+				opponent = i
+				break
+			}
+		}
+
+		pairings = append(pairings, db.TournamentPairing{Player1: first.UID, Player2: remaining[opponent].UID})
+
+		remaining = append(remaining[1:opponent], remaining[opponent+1:]...)
+
+	}
+
+	return pairings
+
+}
+
+// hasPlayed returns true if the player has already played against the opponent in a swiss round
+func hasPlayed(player *Standing, opponent string) bool {
+
+	for _, uid := range player.opponents {
+		if uid == opponent {
+			return true
+		}
+	}
+
+	return false
+
+}
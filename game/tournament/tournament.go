@@ -0,0 +1,538 @@
+package tournament
+
+import (
+	"context"
+	"duel-masters/db"
+	"duel-masters/game/format"
+	"duel-masters/game/match"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Tournament statuses
+const (
+	StatusRegistration = "registration"
+	StatusRunning      = "running"
+	StatusFinished     = "finished"
+)
+
+// Tournament stages
+const (
+	StageSwiss       = "swiss"
+	StageElimination = "elimination"
+)
+
+const maxSwissRounds = 15
+const maxTopCut = 64
+
+// ErrNotFound is returned when a tournament does not exist
+var ErrNotFound = errors.New("The tournament does not exist")
+
+// ErrNotOwner is returned when a user tries to manage a tournament they did not create
+var ErrNotOwner = errors.New("Only the organizer can manage the tournament")
+
+// All changes to tournaments are serialized, results are reported from the match goroutines
+var mutex = &sync.Mutex{}
+
+// Create creates a new tournament that is open for registration. Without swiss rounds
+// the tournament is a single-elimination bracket of all players, otherwise the top cut
+// players of the swiss rounds play a single-elimination bracket
+func Create(owner db.User, name string, rules string, swissRounds int, topCut int) (db.Tournament, error) {
+
+	if _, ok := match.GetRules(rules); !ok {
+		return db.Tournament{}, errors.New("The specified rules do not exist")
+	}
+
+	if swissRounds < 0 || swissRounds > maxSwissRounds {
+		return db.Tournament{}, fmt.Errorf("A tournament can have at most %d swiss rounds", maxSwissRounds)
+	}
+
+	if topCut == 1 || topCut < 0 || topCut > maxTopCut {
+		return db.Tournament{}, fmt.Errorf("The top cut has to be between 2 and %d players", maxTopCut)
+	}
+
+	t := db.Tournament{
+		UID:         uuid.New().String(),
+		Name:        name,
+		Owner:       owner.UID,
+		Rules:       rules,
+		SwissRounds: swissRounds,
+		TopCut:      topCut,
+		Status:      StatusRegistration,
+		Players:     []db.TournamentPlayer{},
+		Rounds:      []db.TournamentRound{},
+		Created:     time.Now().Unix(),
+	}
+
+	if _, err := db.Collection("tournaments").InsertOne(context.TODO(), t); err != nil {
+		return db.Tournament{}, err
+	}
+
+	return t, nil
+
+}
+
+// Get returns the tournament with the given uid
+func Get(uid string) (db.Tournament, error) {
+
+	var t db.Tournament
+
+	if err := db.Collection("tournaments").FindOne(context.TODO(), bson.M{"uid": uid}).Decode(&t); err != nil {
+		return db.Tournament{}, ErrNotFound
+	}
+
+	return t, nil
+
+}
+
+// List returns the most recently created tournaments
+func List(limit int) ([]db.Tournament, error) {
+
+	cur, err := db.Collection("tournaments").Find(
+		context.TODO(),
+		bson.M{},
+		options.Find().SetSort(bson.M{"created": -1}).SetLimit(int64(limit)),
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer cur.Close(context.TODO())
+
+	result := make([]db.Tournament, 0)
+
+	for cur.Next(context.TODO()) {
+
+		var t db.Tournament
+
+		if err := cur.Decode(&t); err != nil {
+			continue
+		}
+
+		result = append(result, t)
+
+	}
+
+	return result, nil
+
+}
+
+// Register registers the user for the tournament with the given deck,
+// or changes the deck if the user is already registered
+func Register(uid string, user db.User, deck string) error {
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	t, err := Get(uid)
+	if err != nil {
+		return err
+	}
+
+	if t.Status != StatusRegistration {
+		return errors.New("The registration for the tournament is closed")
+	}
+
+	var d db.Deck
+
+	if err := db.Collection("decks").FindOne(context.TODO(), bson.M{
+		"uid": deck,
+		"$or": []bson.M{
+			{"owner": user.UID},
+			{"standard": true},
+		},
+	}).Decode(&d); err != nil {
+		return errors.New("The selected deck does not exist")
+	}
+
+	rules, _ := match.GetRules(t.Rules)
+
+	if f, ok := format.Get(rules.Format); ok {
+		if violations := f.Validate(d.Cards); len(violations) > 0 {
+			return fmt.Errorf("%s is not legal in the %s format: %s", d.Name, f.Name, violations[0].Message)
+		}
+	}
+
+	if p := player(&t, user.UID); p != nil {
+		p.Deck = deck
+	} else {
+		t.Players = append(t.Players, db.TournamentPlayer{
+			UID:      user.UID,
+			Username: user.Username,
+			Deck:     deck,
+		})
+	}
+
+	return save(&t)
+
+}
+
+// CheckIn confirms that a registered user will take part in the tournament.
+// Players that have not checked in are removed once the tournament starts
+func CheckIn(uid string, user db.User) error {
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	t, err := Get(uid)
+	if err != nil {
+		return err
+	}
+
+	if t.Status != StatusRegistration {
+		return errors.New("The tournament has already started")
+	}
+
+	p := player(&t, user.UID)
+
+	if p == nil {
+		return errors.New("You are not registered for the tournament")
+	}
+
+	p.CheckedIn = true
+
+	return save(&t)
+
+}
+
+// Drop removes the user from the pairings of all following rounds
+func Drop(uid string, user db.User) error {
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	t, err := Get(uid)
+	if err != nil {
+		return err
+	}
+
+	p := player(&t, user.UID)
+
+	if p == nil {
+		return errors.New("You are not registered for the tournament")
+	}
+
+	if t.Status == StatusRegistration {
+
+		players := make([]db.TournamentPlayer, 0)
+
+		for _, other := range t.Players {
+			if other.UID != user.UID {
+				players = append(players, other)
+			}
+		}
+
+		t.Players = players
+
+		return save(&t)
+
+	}
+
+	if t.Status != StatusRunning {
+		return errors.New("The tournament is over")
+	}
+
+	p.Dropped = true
+
+	return save(&t)
+
+}
+
+// Start closes the registration, removes the players that have not checked in and creates the first round
+func Start(uid string, user db.User) error {
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	t, err := Get(uid)
+	if err != nil {
+		return err
+	}
+
+	if t.Owner != user.UID {
+		return ErrNotOwner
+	}
+
+	if t.Status != StatusRegistration {
+		return errors.New("The tournament has already started")
+	}
+
+	players := make([]db.TournamentPlayer, 0)
+
+	for _, p := range t.Players {
+		if p.CheckedIn {
+			players = append(players, p)
+		}
+	}
+
+	if len(players) < 2 {
+		return errors.New("At least two players have to check in to start the tournament")
+	}
+
+	// The registration order breaks ties, so the first round and the seeding are random
+	rand.Shuffle(len(players), func(i int, j int) {
+		players[i], players[j] = players[j], players[i]
+	})
+
+	t.Players = players
+	t.Status = StatusRunning
+
+	advance(&t)
+
+	return save(&t)
+
+}
+
+// ReportResult lets the organizer record the winner of a pairing, for
+// example if one of the players never showed up to the match
+func ReportResult(uid string, user db.User, matchID string, winner string) error {
+
+	t, err := Get(uid)
+	if err != nil {
+		return err
+	}
+
+	if t.Owner != user.UID {
+		return ErrNotOwner
+	}
+
+	return Report(uid, matchID, winner)
+
+}
+
+// Report records the winner of a tournament match and creates the next round once all results are in
+func Report(uid string, matchID string, winner string) error {
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	t, err := Get(uid)
+	if err != nil {
+		return err
+	}
+
+	if t.Status != StatusRunning {
+		return errors.New("The tournament is not running")
+	}
+
+	round := &t.Rounds[len(t.Rounds)-1]
+
+	for i := range round.Pairings {
+
+		pairing := &round.Pairings[i]
+
+		if pairing.Match != matchID {
+			continue
+		}
+
+		if pairing.Winner != "" {
+			return errors.New("The result of the match has already been reported")
+		}
+
+		if winner != pairing.Player1 && winner != pairing.Player2 {
+			return errors.New("The winner did not play in the match")
+		}
+
+		pairing.Winner = winner
+
+		logrus.Debugf("Reported the result of match %s in tournament %s", matchID, t.UID)
+
+		advance(&t)
+
+		return save(&t)
+
+	}
+
+	return errors.New("The match is not part of the current round")
+
+}
+
+// advance creates the next rounds of the tournament as long as all pairings of the last round have a winner
+func advance(t *db.Tournament) {
+
+	for t.Status == StatusRunning && roundComplete(t) {
+		nextRound(t)
+	}
+
+}
+
+// roundComplete returns true if every pairing of the last round has a winner
+func roundComplete(t *db.Tournament) bool {
+
+	if len(t.Rounds) < 1 {
+		return true
+	}
+
+	for _, pairing := range t.Rounds[len(t.Rounds)-1].Pairings {
+		if pairing.Winner == "" {
+			return false
+		}
+	}
+
+	return true
+
+}
+
+// nextRound creates the next swiss or single-elimination round, or ends the tournament
+func nextRound(t *db.Tournament) {
+
+	swissPlayed := 0
+	var last *db.TournamentRound
+
+	for i := range t.Rounds {
+		if t.Rounds[i].Stage == StageSwiss {
+			swissPlayed++
+		}
+		last = &t.Rounds[i]
+	}
+
+	active := make([]string, 0)
+
+	for _, s := range Standings(t) {
+		if !s.Dropped {
+			active = append(active, s.UID)
+		}
+	}
+
+	var stage string
+	var pairings []db.TournamentPairing
+
+	switch {
+
+	case last != nil && last.Stage == StageElimination:
+		{
+			if len(last.Pairings) < 2 {
+				finish(t, last.Pairings[0].Winner)
+				return
+			}
+
+			stage = StageElimination
+			pairings = nextEliminationPairings(t, last.Pairings)
+		}
+
+	case len(active) < 2:
+		{
+			finish(t, Standings(t)[0].UID)
+			return
+		}
+
+	case swissPlayed < t.SwissRounds:
+		{
+			stage = StageSwiss
+			pairings = swissPairings(t)
+		}
+
+	case t.SwissRounds < 1:
+		{
+			stage = StageElimination
+			pairings = eliminationPairings(active)
+		}
+
+	case t.TopCut >= 2:
+		{
+			if len(active) > t.TopCut {
+				active = active[:t.TopCut]
+			}
+
+			stage = StageElimination
+			pairings = eliminationPairings(active)
+		}
+
+	default:
+		{
+			finish(t, active[0])
+			return
+		}
+
+	}
+
+	round := db.TournamentRound{
+		Number:   len(t.Rounds) + 1,
+		Stage:    stage,
+		Pairings: pairings,
+	}
+
+	for i := range round.Pairings {
+
+		if round.Pairings[i].Player2 == "" {
+			continue
+		}
+
+		round.Pairings[i].Match = createMatch(t, round.Number, round.Pairings[i])
+
+	}
+
+	t.Rounds = append(t.Rounds, round)
+
+	logrus.Debugf("Created round %d of tournament %s", round.Number, t.UID)
+
+}
+
+// finish ends the tournament with the given winner
+func finish(t *db.Tournament, winner string) {
+
+	t.Status = StatusFinished
+	t.Winner = winner
+
+	logrus.Debugf("Tournament %s has finished", t.UID)
+
+}
+
+// createMatch creates the match of a pairing with both seats and decks reserved for the players
+func createMatch(t *db.Tournament, round int, pairing db.TournamentPairing) string {
+
+	rules, _ := match.GetRules(t.Rules)
+
+	m := match.New(fmt.Sprintf("%s, round %d", t.Name, round), pairing.Player1, false, rules)
+	m.GuestID = pairing.Player2
+	m.Tournament = t.UID
+
+	for _, uid := range []string{pairing.Player1, pairing.Player2} {
+		if p := player(t, uid); p != nil {
+			m.PresetDecks[uid] = p.Deck
+		}
+	}
+
+	tournament := t.UID
+	id := m.ID
+
+	m.OnEnd = func(winner string) {
+		if err := Report(tournament, id, winner); err != nil {
+			logrus.Warnf("Could not report the result of match %s in tournament %s. %v", id, tournament, err)
+		}
+	}
+
+	return m.ID
+
+}
+
+// player returns the registered player with the given uid or nil
+func player(t *db.Tournament, uid string) *db.TournamentPlayer {
+
+	for i := range t.Players {
+		if t.Players[i].UID == uid {
+			return &t.Players[i]
+		}
+	}
+
+	return nil
+
+}
+
+// save stores the tournament in the database
+func save(t *db.Tournament) error {
+
+	_, err := db.Collection("tournaments").ReplaceOne(context.TODO(), bson.M{"uid": t.UID}, t)
+
+	return err
+
+}
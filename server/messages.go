@@ -23,17 +23,23 @@ type ChatMessage struct {
 
 // CardState stores information about the state of a card
 type CardState struct {
-	CardID      string `json:"virtualId"`
-	ImageID     string `json:"uid"`
-	Name        string `json:"name"`
-	Civ         string `json:"civilization"`
-	Tapped      bool   `json:"tapped"`
-	CanBePlayed bool   `json:"canBePlayed"`
+	CardID            string      `json:"virtualId"`
+	ImageID           string      `json:"uid"`
+	Name              string      `json:"name"`
+	Civ               string      `json:"civilization"`
+	Tapped            bool        `json:"tapped"`
+	CanBePlayed       bool        `json:"canBePlayed"`
+	Power             int         `json:"power"`
+	SummoningSickness bool        `json:"summoningSickness"`
+	Conditions        []string    `json:"conditions"`
+	Text              []string    `json:"text"`
+	Attachments       []CardState `json:"attachments"`
 }
 
 // PlayerState stores information about the state of the current player
 type PlayerState struct {
 	Deck       int         `json:"deck"`
+	HandCount  int         `json:"handCount"`
 	Hand       []CardState `json:"hand"`
 	Shieldzone []string    `json:"shieldzone"`
 	Manazone   []CardState `json:"manazone"`
@@ -45,6 +51,8 @@ type PlayerState struct {
 type MatchState struct {
 	MyTurn       bool        `json:"myTurn"`
 	HasAddedMana bool        `json:"hasAddedManaThisRound"`
+	TurnNumber   int         `json:"turnNumber"`
+	Step         string      `json:"step"`
 	Me           PlayerState `json:"me"`
 	Opponent     PlayerState `json:"opponent"`
 }
@@ -81,6 +89,36 @@ type MultipartActionMessage struct {
 	Cancellable   bool                   `json:"cancellable"`
 }
 
+// ConfirmActionMessage is used to prompt the user to answer a question with yes or no
+type ConfirmActionMessage struct {
+	Header string `json:"header"`
+	Text   string `json:"text"`
+}
+
+// ModeActionMessage is used to prompt the user to choose one of the modes of a modal ability
+type ModeActionMessage struct {
+	Header      string   `json:"header"`
+	Text        string   `json:"text"`
+	Modes       []string `json:"modes"`
+	Cancellable bool     `json:"cancellable"`
+}
+
+// NumberActionMessage is used to prompt the user to choose a number between min and max
+type NumberActionMessage struct {
+	Header      string `json:"header"`
+	Text        string `json:"text"`
+	Min         int    `json:"min"`
+	Max         int    `json:"max"`
+	Cancellable bool   `json:"cancellable"`
+}
+
+// ShowCardsMessage is used to show cards to the user, e.g. cards revealed by their opponent
+type ShowCardsMessage struct {
+	Header  string      `json:"header"`
+	Message string      `json:"message"`
+	Cards   []CardState `json:"cards"`
+}
+
 // ActionWarningMessage is used to apply an error
 type ActionWarningMessage struct {
 	Header  string `json:"header"`
@@ -113,6 +151,7 @@ type UserMessage struct {
 	Color       string   `json:"color"`
 	Hub         string   `json:"hub"`
 	Permissions []string `json:"permissions"`
+	Rating      int      `json:"rating"`
 }
 
 // UserListMessage is used to send a list of online users
@@ -128,6 +167,7 @@ type MatchMessage struct {
 	Color    string `json:"color"`
 	Name     string `json:"name"`
 	Spectate bool   `json:"spectate"`
+	BestOf   int    `json:"bestOf,omitempty"`
 }
 
 // MatchesListMessage is used to list open matches
@@ -135,3 +175,66 @@ type MatchesListMessage struct {
 	Header  string         `json:"header"`
 	Matches []MatchMessage `json:"matches"`
 }
+
+// PlayerSummary holds the statistics of a player at the end of a match
+type PlayerSummary struct {
+	Username           string `json:"username"`
+	ShieldsBroken      int    `json:"shieldsBroken"`
+	CreaturesDestroyed int    `json:"creaturesDestroyed"`
+	CardsPlayed        int    `json:"cardsPlayed"`
+	Rating             int    `json:"rating,omitempty"`
+	RatingChange       int    `json:"ratingChange,omitempty"`
+	SetWins            int    `json:"setWins"`
+}
+
+// MatchSummaryMessage is sent to both players when a match has ended
+type MatchSummaryMessage struct {
+	Header  string          `json:"header"`
+	Winner  string          `json:"winner"`
+	Reason  string          `json:"reason"`
+	Message string          `json:"message"`
+	Turns   int             `json:"turns"`
+	Players []PlayerSummary `json:"players"`
+
+	// Only used for games that are part of a set
+	BestOf    int    `json:"bestOf,omitempty"`
+	SetWinner string `json:"setWinner,omitempty"`
+}
+
+// RematchOfferMessage lets the players know they can request a rematch within the given amount of seconds
+type RematchOfferMessage struct {
+	Header  string `json:"header"`
+	Timeout int    `json:"timeout"`
+}
+
+// ChooseFirstMessage is sent to the loser of a game in a set to choose who goes first in the next game
+type ChooseFirstMessage struct {
+	Header  string `json:"header"`
+	Timeout int    `json:"timeout"`
+}
+
+// NextGameMessage is used to send the players of a set to its next game
+type NextGameMessage struct {
+	Header string `json:"header"`
+	ID     string `json:"id"`
+	Game   int    `json:"game"`
+}
+
+// RematchMessage is used to send the players to the match created for their rematch
+type RematchMessage struct {
+	Header string `json:"header"`
+	ID     string `json:"id"`
+}
+
+// QueueMessage is used to let a player know the state of their matchmaking queue entry
+type QueueMessage struct {
+	Header  string `json:"header"`
+	Mode    string `json:"mode"`
+	Message string `json:"message"`
+}
+
+// MatchFoundMessage is used to send the players to the match created for them by the matchmaking queue
+type MatchFoundMessage struct {
+	Header string `json:"header"`
+	ID     string `json:"id"`
+}
@@ -69,6 +69,11 @@ func (s *Socket) Ready() bool {
 	return s.ready
 }
 
+// Connected returns true if the socket has neither been closed nor lost its connection
+func (s *Socket) Connected() bool {
+	return !s.closed && !s.lost
+}
+
 // Listen sets up reader and writer for the socket
 func (s *Socket) Listen() {
 
@@ -215,6 +220,7 @@ func GetUserList() UserListMessage {
 			Color:       s.User.Color,
 			Hub:         h.Name(),
 			Permissions: s.User.Permissions,
+			Rating:      s.User.CurrentRating(),
 		}
 
 		if _, ok := usersMap[s.User.Username]; ok {